@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+const (
+	defaultMoveStateSourceProviderAddress = "registry.terraform.io/hashicorp/aws"
+)
+
+// MoveStateTestCase is a recorded source resource state and the target resource state expected after moving it.
+type MoveStateTestCase struct {
+	// SourceProviderAddress defaults to "registry.terraform.io/hashicorp/aws".
+	SourceProviderAddress string
+	SourceSchemaVersion   int64
+	// SourceState is the JSON of the source resource instance's `attributes`, as recorded in a state file.
+	SourceState string
+	// ExpectedState is the JSON of the target resource instance's attributes. Omitted attributes are expected to be null.
+	ExpectedState string
+	ExpectError   *regexp.Regexp
+}
+
+// RunMoveStateTestCases moves each recorded state of the resource type `sourceTypeName` using the state movers of
+// the Plugin Framework resource returned by `factory` and compares the moved state with the expected state.
+func RunMoveStateTestCases(ctx context.Context, t *testing.T, factory func(context.Context) (fwresource.ResourceWithConfigure, error), sourceTypeName string, testCases map[string]MoveStateTestCase) {
+	t.Helper()
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := factory(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			v, ok := r.(fwresource.ResourceWithMoveState)
+			if !ok {
+				t.Fatalf("resource %T does not implement ResourceWithMoveState", r)
+			}
+
+			schemaResponse := fwresource.SchemaResponse{}
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResponse)
+			if schemaResponse.Diagnostics.HasError() {
+				t.Fatal(fwdiag.DiagnosticsError(schemaResponse.Diagnostics))
+			}
+			targetType := schemaResponse.Schema.Type().TerraformType(ctx)
+
+			sourceProviderAddress := testCase.SourceProviderAddress
+			if sourceProviderAddress == "" {
+				sourceProviderAddress = defaultMoveStateSourceProviderAddress
+			}
+			sourceRawState := &tfprotov6.RawState{JSON: []byte(testCase.SourceState)}
+
+			// See the Plugin Framework's fwserver.MoveResourceState.
+			var response *fwresource.MoveStateResponse
+			for _, mover := range v.MoveState(ctx) {
+				request := fwresource.MoveStateRequest{
+					SourceProviderAddress: sourceProviderAddress,
+					SourceRawState:        sourceRawState,
+					SourceSchemaVersion:   testCase.SourceSchemaVersion,
+					SourceTypeName:        sourceTypeName,
+				}
+
+				if mover.SourceSchema != nil {
+					sourceType := mover.SourceSchema.Type().TerraformType(ctx)
+					raw, err := sourceRawState.UnmarshalWithOpts(sourceType, tfprotov6.UnmarshalOpts{
+						ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+							IgnoreUndefinedAttributes: true,
+						},
+					})
+					if err != nil {
+						t.Fatalf("decoding source state: %s", err)
+					}
+
+					request.SourceState = &tfsdk.State{
+						Raw:    raw,
+						Schema: *mover.SourceSchema,
+					}
+				}
+
+				response = &fwresource.MoveStateResponse{
+					TargetState: tfsdk.State{
+						Raw:    tftypes.NewValue(targetType, nil),
+						Schema: schemaResponse.Schema,
+					},
+				}
+				mover.StateMover(ctx, request, response)
+
+				if response.Diagnostics.HasError() || !response.TargetState.Raw.IsNull() {
+					break
+				}
+			}
+
+			if response == nil {
+				t.Fatal("resource has no state movers")
+			}
+
+			if err := fwdiag.DiagnosticsError(response.Diagnostics); err != nil {
+				if testCase.ExpectError == nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if !testCase.ExpectError.MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %s", testCase.ExpectError, err)
+				}
+				return
+			}
+
+			if testCase.ExpectError != nil {
+				t.Fatalf("expected error matching %q, got none", testCase.ExpectError)
+			}
+
+			if response.TargetState.Raw.IsNull() {
+				t.Fatalf("no state mover moved %s state", sourceTypeName)
+			}
+
+			expected, err := (&tfprotov6.RawState{JSON: []byte(testCase.ExpectedState)}).Unmarshal(targetType)
+			if err != nil {
+				t.Fatalf("decoding expected state: %s", err)
+			}
+
+			diffs, err := response.TargetState.Raw.Diff(expected)
+			if err != nil {
+				t.Fatalf("comparing states: %s", err)
+			}
+
+			for _, diff := range diffs {
+				t.Errorf("%s: got %s, want %s", diff.Path, valueString(diff.Value1), valueString(diff.Value2))
+			}
+		})
+	}
+}
+
+func valueString(v *tftypes.Value) string {
+	if v == nil {
+		return "<absent>"
+	}
+
+	return v.String()
+}
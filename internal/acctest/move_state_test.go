@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestStateMoverFromSDKv2Resource(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	acctest.RunMoveStateTestCases(ctx, t, newTestMovedResource, "aws_test_legacy", map[string]acctest.MoveStateTestCase{
+		"schema version 0": {
+			SourceSchemaVersion: 0,
+			SourceState:         `{"id": "test-1", "display_name": "test", "instance_size": 2, "configuration": [{"mode": "fast"}], "removed": "x"}`,
+			ExpectedState:       `{"id": "test-1", "name": "test", "size": 2, "configuration": [{"mode": "fast"}]}`,
+		},
+		"schema version 1": {
+			SourceSchemaVersion: 1,
+			SourceState:         `{"id": "test-1", "display_name": "test", "size": 3, "configuration": [], "tags": {"key1": "value1"}}`,
+			ExpectedState:       `{"id": "test-1", "name": "test", "size": 3, "configuration": [], "tags": {"key1": "value1"}}`,
+		},
+		"normalized": {
+			SourceSchemaVersion: 1,
+			SourceState:         `{"id": "test-1", "display_name": "test", "size": "3", "configuration": [{"mode": "fast", "removed": "x"}], "removed": "x"}`,
+			ExpectedState:       `{"id": "test-1", "name": "test", "size": 3, "configuration": [{"mode": "fast"}]}`,
+		},
+		"upgrader error": {
+			SourceSchemaVersion: 0,
+			SourceState:         `{"id": "test-1", "display_name": "test", "instance_size": "large"}`,
+			ExpectError:         regexp.MustCompile(`upgrading from schema version 0`),
+		},
+		"newer schema version": {
+			SourceSchemaVersion: 2,
+			SourceState:         `{"id": "test-1", "display_name": "test", "size": 3}`,
+			ExpectError:         regexp.MustCompile(`newer than the supported version 1`),
+		},
+	})
+}
+
+func TestStateMoverFromSDKv2ResourceMigrateState(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	acctest.RunMoveStateTestCases(ctx, t, newTestMovedResource, "aws_test_legacy_migrated", map[string]acctest.MoveStateTestCase{
+		"schema version 0": {
+			SourceSchemaVersion: 0,
+			SourceState:         `{"id": "test-1", "name": "test"}`,
+			ExpectError:         regexp.MustCompile(`schema version 0 state can only be migrated by MigrateState`),
+		},
+		"schema version 1": {
+			SourceSchemaVersion: 1,
+			SourceState:         `{"id": "test-1", "name": "test", "size": 3}`,
+			ExpectedState:       `{"id": "test-1", "name": "test", "size": 3}`,
+		},
+	})
+}
+
+type testMovedResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpRead
+	framework.WithNoOpUpdate[testMovedResourceModel]
+	framework.WithNoOpDelete
+}
+
+type testMovedResourceModel struct {
+	Configuration types.List   `tfsdk:"configuration"`
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Size          types.Int64  `tfsdk:"size"`
+	Tags          types.Map    `tfsdk:"tags"`
+}
+
+func newTestMovedResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &testMovedResource{}, nil
+}
+
+func (*testMovedResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test_moved"
+}
+
+func (*testMovedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = fwschema.Schema{
+		Attributes: map[string]fwschema.Attribute{
+			names.AttrID: fwschema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: fwschema.StringAttribute{
+				Required: true,
+			},
+			"size": fwschema.Int64Attribute{
+				Optional: true,
+			},
+			names.AttrTags: fwschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]fwschema.Block{
+			names.AttrConfiguration: fwschema.ListNestedBlock{
+				NestedObject: fwschema.NestedBlockObject{
+					Attributes: map[string]fwschema.Attribute{
+						"mode": fwschema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (*testMovedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+}
+
+func (r *testMovedResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		framework.StateMoverFromSDKv2Resource("aws_test_legacy", testLegacyResource(),
+			framework.WithSDKv2StateMoverMeta(r.Meta()),
+			framework.WithSDKv2StateMoverTransform(func(_ context.Context, state map[string]any) (map[string]any, error) {
+				state[names.AttrName] = state["display_name"]
+				delete(state, "display_name")
+
+				return state, nil
+			}),
+		),
+		framework.StateMoverFromSDKv2Resource("aws_test_legacy_migrated", testLegacyMigratedResource(),
+			framework.WithSDKv2StateMoverMeta(r.Meta()),
+		),
+	}
+}
+
+func testLegacyResource() *schema.Resource {
+	return &schema.Resource{
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    testLegacyResourceV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: testLegacyResourceStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrConfiguration: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			names.AttrTags: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// testLegacyMigratedResource upgrades schema version 0 state using the legacy MigrateState function.
+func testLegacyMigratedResource() *schema.Resource {
+	return &schema.Resource{
+		SchemaVersion: 1,
+		MigrateState: func(_ int, is *terraform.InstanceState, _ any) (*terraform.InstanceState, error) {
+			return is, nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func testLegacyResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrConfiguration: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"removed": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func testLegacyResourceStateUpgradeV0(_ context.Context, rawState map[string]any, meta any) (map[string]any, error) {
+	if v, ok := rawState["instance_size"]; ok {
+		if _, ok := v.(float64); !ok {
+			return nil, fmt.Errorf("unexpected instance_size type: %T", v)
+		}
+		rawState["size"] = v
+		delete(rawState, "instance_size")
+	}
+	delete(rawState, "removed")
+
+	return rawState, nil
+}
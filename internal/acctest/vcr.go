@@ -9,6 +9,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
@@ -20,6 +21,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -72,9 +75,30 @@ func (m randomnessSourceMap) key() string {
 	return "vcr-randomness-sources"
 }
 
+// vcrRecorder is the VCR recorder shared by all of a test's provider instances.
+type vcrRecorder struct {
+	recorder *recorder.Recorder
+	scrubber *vcrScrubPipeline
+}
+
+type recorderMap map[string]*vcrRecorder
+
+func (m recorderMap) Lock() {
+	conns.GlobalMutexKV.Lock(m.key())
+}
+
+func (m recorderMap) Unlock() {
+	conns.GlobalMutexKV.Unlock(m.key())
+}
+
+func (m recorderMap) key() string {
+	return "vcr-recorders"
+}
+
 var (
 	providerMetas     = metaMap(make(map[string]*conns.AWSClient, 0))
 	randomnessSources = randomnessSourceMap(make(map[string]*randomnessSource, 0))
+	recorders         = recorderMap(make(map[string]*vcrRecorder, 0))
 )
 
 // ProviderMeta returns the current provider's state (AKA "meta" or "conns.AWSClient").
@@ -108,6 +132,9 @@ func vcrMode() (recorder.Mode, error) {
 }
 
 // vcrEnabledProtoV5ProviderFactories returns ProtoV5ProviderFactories ready for use with VCR.
+// Each named provider, for example the alternate provider, has its own instance state.
+// All of a test's provider instances, and so all of the resources, data sources, ephemeral resources and functions
+// served through the provider muxer, share one VCR recorder.
 func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
 	t.Helper()
 
@@ -121,7 +148,7 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 				return nil, err
 			}
 
-			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name())
+			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name(), name)
 
 			return providerServerFactory(), nil
 		}
@@ -130,164 +157,210 @@ func vcrEnabledProtoV5ProviderFactories(ctx context.Context, t *testing.T, input
 	return output
 }
 
+// vcrProviderMetaKey returns the key under which a named provider's instance state is cached for a test.
+func vcrProviderMetaKey(testName, providerName string) string {
+	if providerName == ProviderName {
+		return testName
+	}
+
+	return fmt.Sprintf("%s[%s]", testName, providerName)
+}
+
 // vcrProviderConfigureContextFunc returns a provider configuration function returning cached provider instance state.
 // This is necessary as ConfigureContextFunc is called multiple times for a given test, each time creating a new HTTP client.
 // VCR requires a single HTTP client to handle all interactions.
-func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, testName string) schema.ConfigureContextFunc {
+func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContextFunc schema.ConfigureContextFunc, testName, providerName string) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		var diags diag.Diagnostics
 
+		key := vcrProviderMetaKey(testName, providerName)
+
 		providerMetas.Lock()
-		meta, ok := providerMetas[testName]
+		meta, ok := providerMetas[key]
 		defer providerMetas.Unlock()
 
 		if ok {
 			return meta, nil
 		}
 
-		vcrMode, err := vcrMode()
+		r, err := vcrTestRecorder(ctx, testName)
 
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Cribbed from aws-sdk-go-base.
-		httpClient := cleanhttp.DefaultPooledClient()
-		transport := httpClient.Transport.(*http.Transport)
-		transport.MaxIdleConnsPerHost = 10
-		tlsConfig := transport.TLSClientConfig
-		if tlsConfig == nil {
-			tlsConfig = &tls.Config{}
-			transport.TLSClientConfig = tlsConfig
+		// Use the wrapped HTTP Client for AWS APIs.
+		// As the HTTP client is used in the provider's ConfigureContextFunc
+		// we must do this setup before calling the ConfigureContextFunc.
+		httpClient := &http.Client{Transport: r.recorder}
+		if v, ok := provider.Meta().(*conns.AWSClient); ok {
+			meta = v
+		} else {
+			meta = new(conns.AWSClient)
 		}
-		tlsConfig.MinVersion = tls.VersionTLS12
+		meta.SetHTTPClient(ctx, httpClient)
+		provider.SetMeta(meta)
 
-		path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
+		if v, ds := configureContextFunc(ctx, d); ds.HasError() {
+			return nil, append(diags, ds...)
+		} else {
+			meta = v.(*conns.AWSClient)
+		}
 
-		// Create a VCR recorder around a default HTTP client.
-		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
-		})
+		// The account ID doesn't always appear in an ARN.
+		r.scrubber.scrubMap.Register(meta.AccountID(ctx), vcrAccountIDPrefix, 12, true)
 
-		if err != nil {
-			return nil, sdkdiag.AppendFromErr(diags, err)
+		// Don't retry requests if a recorded interaction isn't found.
+		conns.AddAWSConfigIsErrorRetryables(meta, retry.IsErrorRetryableFunc(func(err error) aws.Ternary {
+			if errors.Is(err, cassette.ErrInteractionNotFound) {
+				return aws.FalseTernary
+			}
+			return aws.UnknownTernary
+		}))
+
+		providerMetas[key] = meta
+
+		return meta, diags
+	}
+}
+
+// vcrTestRecorder returns the VCR recorder for the specified test, creating it if necessary.
+func vcrTestRecorder(ctx context.Context, testName string) (*vcrRecorder, error) {
+	recorders.Lock()
+	defer recorders.Unlock()
+
+	if v, ok := recorders[testName]; ok {
+		return v, nil
+	}
+
+	vcrMode, err := vcrMode()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Cribbed from aws-sdk-go-base.
+	httpClient := cleanhttp.DefaultPooledClient()
+	transport := httpClient.Transport.(*http.Transport)
+	transport.MaxIdleConnsPerHost = 10
+	tlsConfig := transport.TLSClientConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+		transport.TLSClientConfig = tlsConfig
+	}
+	tlsConfig.MinVersion = tls.VersionTLS12
+
+	path := filepath.Join(os.Getenv(envVarVCRPath), vcrFileName(testName))
+
+	// Create a VCR recorder around a default HTTP client.
+	r, err := recorder.NewWithOptions(&recorder.Options{
+		CassetteName:  path,
+		Mode:          vcrMode,
+		RealTransport: httpClient.Transport,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	scrubber := newVCRScrubPipeline()
+
+	// Remove sensitive HTTP headers.
+	r.AddHook(func(i *cassette.Interaction) error {
+		delete(i.Request.Headers, "Authorization")
+		delete(i.Request.Headers, "X-Amz-Security-Token")
+
+		return nil
+	}, recorder.AfterCaptureHook)
+
+	// Scrub sensitive values from the cassette.
+	// While recording the provider must see real values, so interactions are only scrubbed as the cassette is saved.
+	r.AddHook(scrubber.scrubInteraction, recorder.BeforeSaveHook)
+
+	// Restore real values in replayed responses.
+	if vcrMode == recorder.ModeReplayOnly {
+		r.AddHook(scrubber.unscrubResponse, recorder.BeforeResponseReplayHook)
+	}
+
+	// Defines how VCR will match requests to responses.
+	// Requests are scrubbed so that they can be compared with the scrubbed cassette.
+	r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method || scrubber.scrub(r.URL.String()) != i.URL {
+			return false
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
+		if r.Body == nil {
+			return true
+		}
 
-			return nil
-		}, recorder.AfterCaptureHook)
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
 
-		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
+		r.Body = io.NopCloser(&b)
+		body := scrubber.scrub(b.String())
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
 
-			if r.Body == nil {
-				return true
-			}
+		// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
+		switch contentType := r.Header.Get("Content-Type"); contentType {
+		case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+			// JSON might be the same, but reordered. Try parsing and comparing.
+			var requestJson, cassetteJson interface{}
 
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+			if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
 					"error": err,
 				})
 				return false
 			}
 
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
+			if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
+					"error": err,
+				})
+				return false
 			}
 
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
+			return reflect.DeepEqual(requestJson, cassetteJson)
 
-			return false
-		})
+		case "application/xml":
+			// XML might be the same, but reordered. Try parsing and comparing.
+			var requestXml, cassetteXml interface{}
 
-		// Use the wrapped HTTP Client for AWS APIs.
-		// As the HTTP client is used in the provider's ConfigureContextFunc
-		// we must do this setup before calling the ConfigureContextFunc.
-		httpClient.Transport = r
-		if v, ok := provider.Meta().(*conns.AWSClient); ok {
-			meta = v
-		} else {
-			meta = new(conns.AWSClient)
-		}
-		meta.SetHTTPClient(ctx, httpClient)
-		provider.SetMeta(meta)
+			if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
 
-		if v, ds := configureContextFunc(ctx, d); ds.HasError() {
-			return nil, append(diags, ds...)
-		} else {
-			meta = v.(*conns.AWSClient)
+			if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
+				tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
+					"error": err,
+				})
+				return false
+			}
+
+			return reflect.DeepEqual(requestXml, cassetteXml)
 		}
 
-		// Don't retry requests if a recorded interaction isn't found.
-		// TODO Need to loop through all API clients to do this.
-		// TODO Use []*client.Client?
-		// TODO AWS SDK for Go v2 API clients.
-		// meta.LogsConn(ctx).Handlers.AfterRetry.PushFront(func(r *request.Request) {
-		// 	// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
-		// 	if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
-		// 		r.Retryable = aws.Bool(false)
-		// 	}
-		// })
-
-		providerMetas[testName] = meta
+		return false
+	})
 
-		return meta, diags
+	v := &vcrRecorder{
+		recorder: r,
+		scrubber: scrubber,
 	}
+	recorders[testName] = v
+
+	return v, nil
 }
 
 // vcrRandomnessSource returns a rand.Source for VCR testing.
@@ -383,7 +456,7 @@ func writeSeedToFile(seed int64, fileName string) error {
 }
 
 // closeVCRRecorder closes the VCR recorder, saving the cassette and randomness seed.
-func closeVCRRecorder(ctx context.Context, t *testing.T) {
+func closeVCRRecorder(_ context.Context, t *testing.T) {
 	t.Helper()
 
 	// Don't close the recorder if we're running because of a panic.
@@ -392,22 +465,29 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 
 	testName := t.Name()
-	providerMetas.Lock()
-	meta, ok := providerMetas[testName]
-	defer providerMetas.Unlock()
+
+	// Provider configuration locks the provider metas before the recorders, so don't hold both locks here.
+	recorders.Lock()
+	r, ok := recorders[testName]
+	delete(recorders, testName)
+	recorders.Unlock()
 
 	if ok {
 		if !t.Failed() {
-			if v, ok := meta.HTTPClient(ctx).Transport.(*recorder.Recorder); ok {
-				t.Log("stopping VCR recorder")
-				if err := v.Stop(); err != nil {
-					t.Error(err)
-				}
+			t.Log("stopping VCR recorder")
+			if err := r.recorder.Stop(); err != nil {
+				t.Error(err)
 			}
 		}
+	}
 
-		delete(providerMetas, testName)
+	providerMetas.Lock()
+	for key := range providerMetas {
+		if key == testName || strings.HasPrefix(key, testName+"[") {
+			delete(providerMetas, key)
+		}
 	}
+	providerMetas.Unlock()
 
 	// Save the randomness seed.
 	randomnessSources.Lock()
@@ -426,12 +506,30 @@ func closeVCRRecorder(ctx context.Context, t *testing.T) {
 	}
 }
 
+// vcrEnabledTestCase returns the test case with all of its provider factories, including those of individual test steps, ready for use with VCR.
+func vcrEnabledTestCase(ctx context.Context, t *testing.T, c resource.TestCase) resource.TestCase {
+	t.Helper()
+
+	c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
+
+	steps := make([]resource.TestStep, len(c.Steps))
+	for i, step := range c.Steps {
+		if step.ProtoV5ProviderFactories != nil {
+			step.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, step.ProtoV5ProviderFactories)
+		}
+		steps[i] = step
+	}
+	c.Steps = steps
+
+	return c
+}
+
 // ParallelTest wraps resource.ParallelTest, initializing VCR if enabled.
 func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
 	if isVCREnabled() {
-		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
+		c = vcrEnabledTestCase(ctx, t, c)
 		defer closeVCRRecorder(ctx, t)
 	}
 
@@ -443,7 +541,7 @@ func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
 	if isVCREnabled() {
-		c.ProtoV5ProviderFactories = vcrEnabledProtoV5ProviderFactories(ctx, t, c.ProtoV5ProviderFactories)
+		c = vcrEnabledTestCase(ctx, t, c)
 		defer closeVCRRecorder(ctx, t)
 	}
 
@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// VCRScrubber rewrites sensitive values in a string recorded in a VCR cassette.
+// Values that must be restored on replay are registered in the VCRScrubMap, which replaces each of them with a placeholder.
+// Placeholders are derived from the real value so that the same value is scrubbed identically on record and on replay.
+type VCRScrubber func(m *VCRScrubMap, s string) string
+
+var (
+	vcrScrubbersLock sync.Mutex
+	vcrScrubbers     = []VCRScrubber{
+		ScrubVCRAccountIDs,
+		ScrubVCRAccessKeys,
+		ScrubVCRS3BucketNames,
+		ScrubVCRPresignedURLs,
+	}
+)
+
+// AddVCRScrubbers adds scrubbers to the pipeline run over every recorded VCR interaction.
+func AddVCRScrubbers(scrubbers ...VCRScrubber) {
+	vcrScrubbersLock.Lock()
+	defer vcrScrubbersLock.Unlock()
+
+	vcrScrubbers = append(vcrScrubbers, scrubbers...)
+}
+
+// VCRScrubMap is a two-way mapping between real values and the placeholders that replace them in a cassette.
+type VCRScrubMap struct {
+	lock         sync.RWMutex
+	placeholders map[string]string // Real value to placeholder.
+	reals        map[string]string // Placeholder to real value.
+}
+
+func newVCRScrubMap() *VCRScrubMap {
+	return &VCRScrubMap{
+		placeholders: make(map[string]string),
+		reals:        make(map[string]string),
+	}
+}
+
+// Register returns the placeholder for the specified real value, recording the mapping.
+// The placeholder is the prefix followed by a hash of the real value, truncated so that the placeholder has the specified length.
+// A value that is already a placeholder is returned unchanged.
+func (m *VCRScrubMap) Register(real, prefix string, length int, digits bool) string {
+	if real == "" || strings.HasPrefix(real, prefix) {
+		return real
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if v, ok := m.placeholders[real]; ok {
+		return v
+	}
+	if _, ok := m.reals[real]; ok {
+		return real
+	}
+
+	sum := sha256.Sum256([]byte(real))
+	hash := hex.EncodeToString(sum[:])
+	if digits {
+		var b strings.Builder
+		for _, c := range sum {
+			fmt.Fprintf(&b, "%d", c%10)
+		}
+		hash = b.String()
+	}
+	placeholder := prefix + hash[:max(length-len(prefix), 0)]
+
+	m.placeholders[real] = placeholder
+	m.reals[placeholder] = real
+
+	return placeholder
+}
+
+// Scrub replaces every registered real value in s with its placeholder.
+func (m *VCRScrubMap) Scrub(s string) string {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return replaceAll(s, m.placeholders)
+}
+
+// Unscrub replaces every registered placeholder in s with its real value.
+func (m *VCRScrubMap) Unscrub(s string) string {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return replaceAll(s, m.reals)
+}
+
+func replaceAll(s string, replacements map[string]string) string {
+	if s == "" || len(replacements) == 0 {
+		return s
+	}
+
+	// Longer values take precedence over any value that they start with.
+	olds := slices.SortedFunc(maps.Keys(replacements), func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(b), len(a)), cmp.Compare(a, b))
+	})
+	oldnew := make([]string, 0, 2*len(olds))
+	for _, v := range olds {
+		oldnew = append(oldnew, v, replacements[v])
+	}
+
+	return strings.NewReplacer(oldnew...).Replace(s)
+}
+
+const (
+	// Placeholder prefixes. Real values never start with these prefixes.
+	vcrAccountIDPrefix     = "0000"
+	vcrAccessKeyIDPrefix   = "AKIAVCR"
+	vcrS3BucketNamePrefix  = "vcr-bucket-"
+	vcrScrubbedPlaceholder = "VCR_SCRUBBED"
+)
+
+var (
+	vcrARNAccountIDRegexp = regexp.MustCompile(`(arn:[\w-]+:[\w-]*:[\w-]*:)(\d{12})(:)`)
+	vcrAccessKeyIDRegexp  = regexp.MustCompile(`\b(?:AKIA|ASIA)[A-Z0-9]{16}\b`)
+	vcrSecretRegexp       = regexp.MustCompile(`(<(?:SecretAccessKey|SessionToken)>)[^<]*(</(?:SecretAccessKey|SessionToken)>)|("(?:SecretAccessKey|SessionToken|secretAccessKey|sessionToken)"\s*:\s*")[^"]*(")`)
+	vcrS3HostRegexp       = regexp.MustCompile(`^https?://([a-z0-9][a-z0-9.-]{1,61}[a-z0-9])\.s3[.-](?:[a-z0-9-]+\.)*amazonaws\.com`)
+	vcrS3PathRegexp       = regexp.MustCompile(`^https?://s3[.-](?:[a-z0-9-]+\.)*amazonaws\.com/([a-z0-9][a-z0-9.-]{1,61}[a-z0-9])\b`)
+	vcrPresignedRegexp    = regexp.MustCompile(`((?:X-Amz-Signature|X-Amz-Credential|X-Amz-Security-Token|Signature|AWSAccessKeyId)=)[^&"'\s<]*`)
+)
+
+// ScrubVCRAccountIDs replaces the AWS account IDs in ARNs.
+func ScrubVCRAccountIDs(m *VCRScrubMap, s string) string {
+	for _, match := range vcrARNAccountIDRegexp.FindAllStringSubmatch(s, -1) {
+		m.Register(match[2], vcrAccountIDPrefix, 12, true)
+	}
+
+	return m.Scrub(s)
+}
+
+// ScrubVCRAccessKeys replaces AWS access key IDs and removes secret access keys and session tokens.
+func ScrubVCRAccessKeys(m *VCRScrubMap, s string) string {
+	for _, match := range vcrAccessKeyIDRegexp.FindAllString(s, -1) {
+		m.Register(match, vcrAccessKeyIDPrefix, 20, false)
+	}
+
+	s = vcrSecretRegexp.ReplaceAllStringFunc(s, func(match string) string {
+		v := vcrSecretRegexp.FindStringSubmatch(match)
+		if v[1] != "" {
+			return v[1] + vcrScrubbedPlaceholder + v[2]
+		}
+		return v[3] + vcrScrubbedPlaceholder + v[4]
+	})
+
+	return m.Scrub(s)
+}
+
+// ScrubVCRS3BucketNames replaces the names of S3 buckets.
+// Bucket names are discovered in S3 request URLs only, as S3 responses contain other URLs, like XML namespaces.
+func ScrubVCRS3BucketNames(m *VCRScrubMap, s string) string {
+	if strings.HasPrefix(s, "https://") || strings.HasPrefix(s, "http://") {
+		for _, re := range []*regexp.Regexp{vcrS3HostRegexp, vcrS3PathRegexp} {
+			if match := re.FindStringSubmatch(s); match != nil && strings.Trim(match[1], "0123456789") != "" {
+				m.Register(match[1], vcrS3BucketNamePrefix, len(vcrS3BucketNamePrefix)+16, false)
+			}
+		}
+	}
+
+	return m.Scrub(s)
+}
+
+// ScrubVCRPresignedURLs removes the signatures and credentials from presigned URLs.
+func ScrubVCRPresignedURLs(_ *VCRScrubMap, s string) string {
+	return vcrPresignedRegexp.ReplaceAllString(s, "${1}"+vcrScrubbedPlaceholder)
+}
+
+// vcrScrubPipeline runs the registered scrubbers over VCR interactions.
+type vcrScrubPipeline struct {
+	scrubMap  *VCRScrubMap
+	scrubbers []VCRScrubber
+}
+
+func newVCRScrubPipeline() *vcrScrubPipeline {
+	vcrScrubbersLock.Lock()
+	defer vcrScrubbersLock.Unlock()
+
+	return &vcrScrubPipeline{
+		scrubMap:  newVCRScrubMap(),
+		scrubbers: append([]VCRScrubber(nil), vcrScrubbers...),
+	}
+}
+
+func (p *vcrScrubPipeline) scrub(s string) string {
+	for _, scrubber := range p.scrubbers {
+		s = scrubber(p.scrubMap, s)
+	}
+
+	// Values discovered by later scrubbers may appear before them in the pipeline.
+	return p.scrubMap.Scrub(s)
+}
+
+func (p *vcrScrubPipeline) scrubHeaders(h http.Header) {
+	for k, vs := range h {
+		for i, v := range vs {
+			vs[i] = p.scrub(v)
+		}
+		h[k] = vs
+	}
+}
+
+func (p *vcrScrubPipeline) scrubForm(form url.Values) {
+	for k, vs := range form {
+		for i, v := range vs {
+			vs[i] = p.scrub(v)
+		}
+		form[k] = vs
+	}
+}
+
+// scrubInteraction scrubs a recorded interaction before it's saved.
+// The URL is scrubbed first so that values discovered there, like S3 bucket names, are scrubbed everywhere.
+func (p *vcrScrubPipeline) scrubInteraction(i *cassette.Interaction) error {
+	i.Request.URL = p.scrub(i.Request.URL)
+	i.Request.Host = p.scrub(i.Request.Host)
+	i.Request.RequestURI = p.scrub(i.Request.RequestURI)
+	i.Request.Body = p.scrub(i.Request.Body)
+	if i.Request.ContentLength > 0 {
+		i.Request.ContentLength = int64(len(i.Request.Body))
+	}
+	p.scrubForm(i.Request.Form)
+	p.scrubHeaders(i.Request.Headers)
+
+	i.Response.Body = p.scrub(i.Response.Body)
+	if i.Response.ContentLength > 0 {
+		i.Response.ContentLength = int64(len(i.Response.Body))
+	}
+	p.scrubHeaders(i.Response.Headers)
+
+	return nil
+}
+
+// unscrubResponse restores the real values, seen in requests during replay, in a replayed response.
+func (p *vcrScrubPipeline) unscrubResponse(i *cassette.Interaction) error {
+	i.Response.Body = p.scrubMap.Unscrub(i.Response.Body)
+	if i.Response.ContentLength > 0 {
+		i.Response.ContentLength = int64(len(i.Response.Body))
+	}
+	for k, vs := range i.Response.Headers {
+		for j, v := range vs {
+			vs[j] = p.scrubMap.Unscrub(v)
+		}
+		i.Response.Headers[k] = vs
+	}
+
+	return nil
+}
@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	// APIMetricsFileEnvVar names the file to which a summary of the provider's API calls is written when the provider exits.
+	// The provider process ID is added to the file name, see apiMetricsFilePath.
+	APIMetricsFileEnvVar = "TF_AWS_API_METRICS_FILE"
+	// APIMetricsFormatEnvVar is the format of the API call summary, `json` (the default) or `openmetrics`.
+	APIMetricsFormatEnvVar = "TF_AWS_API_METRICS_FORMAT"
+)
+
+const (
+	apiMetricsFormatJSON        = "json"
+	apiMetricsFormatOpenMetrics = "openmetrics"
+)
+
+// apiMetrics is the process-wide API call metrics collector.
+// It is shared by all provider instances, for example aliased providers, in the provider process.
+var apiMetrics = newAPIMetricsCollector()
+
+// apiMetricsEnabled returns whether API call metrics are collected.
+func apiMetricsEnabled() bool {
+	return os.Getenv(APIMetricsFileEnvVar) != ""
+}
+
+// WriteAPIMetrics writes the summary of the provider's API calls to the file named by the TF_AWS_API_METRICS_FILE environment variable,
+// with the provider process ID added to the file name.
+// It does nothing if the environment variable is not set.
+func WriteAPIMetrics() error {
+	path := os.Getenv(APIMetricsFileEnvVar)
+	if path == "" {
+		return nil
+	}
+	path = apiMetricsFilePath(path, os.Getpid())
+
+	format := cmp.Or(strings.ToLower(os.Getenv(APIMetricsFormatEnvVar)), apiMetricsFormatJSON)
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("writing API metrics: %w", err)
+	}
+
+	if err := apiMetrics.write(f, format); err != nil {
+		f.Close()
+		return fmt.Errorf("writing API metrics (%s): %w", path, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("writing API metrics (%s): %w", path, err)
+	}
+
+	return nil
+}
+
+// apiMetricsFilePath returns the path of the API call summary file for the provider process with the specified ID.
+// Terraform runs a separate provider process for each of validate, plan and apply, so each process writes its own file
+// rather than overwriting or appending to a shared one. The process ID is added before the file extension,
+// for example "aws-api-metrics.json" becomes "aws-api-metrics.12345.json".
+func apiMetricsFilePath(path string, pid int) string {
+	ext := filepath.Ext(path)
+
+	return strings.TrimSuffix(path, ext) + "." + strconv.Itoa(pid) + ext
+}
+
+type apiMetricsKey struct {
+	service   string
+	operation string
+}
+
+// apiOperationMetrics are the metrics for one service operation.
+type apiOperationMetrics struct {
+	Service   string `json:"service"`
+	Operation string `json:"operation"`
+	// Calls is the number of API calls. Retries of an API call are not counted as separate calls.
+	Calls int64 `json:"calls"`
+	// Errors is the number of API calls that failed after any retries.
+	Errors int64 `json:"errors"`
+	// Throttles is the number of API call attempts that were throttled.
+	Throttles int64 `json:"throttles"`
+	// Retries is the number of API call attempts that were retries.
+	Retries int64 `json:"retries"`
+	// LatencySeconds is the total time taken by API calls, including retries.
+	LatencySeconds float64 `json:"latency_seconds"`
+	// MaxLatencySeconds is the time taken by the slowest API call, including retries.
+	MaxLatencySeconds float64 `json:"max_latency_seconds"`
+}
+
+type apiMetricsCollector struct {
+	lock       sync.Mutex
+	operations map[apiMetricsKey]*apiOperationMetrics
+}
+
+func newAPIMetricsCollector() *apiMetricsCollector {
+	return &apiMetricsCollector{
+		operations: make(map[apiMetricsKey]*apiOperationMetrics),
+	}
+}
+
+// record records the result of an API call.
+// The attempt results are those recorded by the AWS SDK for Go v2 retry middleware.
+func (c *apiMetricsCollector) record(servicePackageName, operation string, latency time.Duration, err error, attempts []retry.AttemptResult) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := apiMetricsKey{service: servicePackageName, operation: operation}
+	v, ok := c.operations[key]
+	if !ok {
+		v = &apiOperationMetrics{
+			Service:   servicePackageName,
+			Operation: operation,
+		}
+		c.operations[key] = v
+	}
+
+	v.Calls++
+	if err != nil {
+		v.Errors++
+	}
+	if n := len(attempts); n > 1 {
+		v.Retries += int64(n - 1)
+	}
+	for _, attempt := range attempts {
+		if attempt.Err != nil && retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(attempt.Err).Bool() {
+			v.Throttles++
+		}
+	}
+	seconds := latency.Seconds()
+	v.LatencySeconds += seconds
+	v.MaxLatencySeconds = max(v.MaxLatencySeconds, seconds)
+}
+
+// snapshot returns a copy of the metrics, ordered by service and operation.
+func (c *apiMetricsCollector) snapshot() []apiOperationMetrics {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	keys := slices.SortedFunc(maps.Keys(c.operations), func(a, b apiMetricsKey) int {
+		return cmp.Or(cmp.Compare(a.service, b.service), cmp.Compare(a.operation, b.operation))
+	})
+
+	operations := make([]apiOperationMetrics, 0, len(keys))
+	for _, key := range keys {
+		operations = append(operations, *c.operations[key])
+	}
+
+	return operations
+}
+
+func (c *apiMetricsCollector) write(w io.Writer, format string) error {
+	operations := c.snapshot()
+
+	switch format {
+	case apiMetricsFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(struct {
+			Operations []apiOperationMetrics `json:"operations"`
+		}{
+			Operations: operations,
+		})
+
+	case apiMetricsFormatOpenMetrics:
+		return writeOpenMetrics(w, operations)
+
+	default:
+		return fmt.Errorf("unsupported format: %q", format)
+	}
+}
+
+// writeOpenMetrics writes the metrics in the OpenMetrics text format.
+// See https://github.com/OpenObservability/OpenMetrics/blob/main/specification/OpenMetrics.md.
+func writeOpenMetrics(w io.Writer, operations []apiOperationMetrics) error {
+	var b strings.Builder
+
+	counters := []struct {
+		name  string
+		help  string
+		value func(apiOperationMetrics) int64
+	}{
+		{"tf_aws_api_calls", "Number of AWS API calls.", func(v apiOperationMetrics) int64 { return v.Calls }},
+		{"tf_aws_api_errors", "Number of AWS API calls that failed.", func(v apiOperationMetrics) int64 { return v.Errors }},
+		{"tf_aws_api_throttles", "Number of AWS API call attempts that were throttled.", func(v apiOperationMetrics) int64 { return v.Throttles }},
+		{"tf_aws_api_retries", "Number of AWS API call attempts that were retries.", func(v apiOperationMetrics) int64 { return v.Retries }},
+	}
+
+	for _, counter := range counters {
+		fmt.Fprintf(&b, "# TYPE %s counter\n", counter.name)
+		fmt.Fprintf(&b, "# HELP %s %s\n", counter.name, counter.help)
+		for _, v := range operations {
+			fmt.Fprintf(&b, "%s_total{%s} %d\n", counter.name, openMetricsLabels(v), counter.value(v))
+		}
+	}
+
+	const latency = "tf_aws_api_call_duration_seconds"
+	fmt.Fprintf(&b, "# TYPE %s summary\n", latency)
+	fmt.Fprintf(&b, "# UNIT %s seconds\n", latency)
+	fmt.Fprintf(&b, "# HELP %s Time taken by AWS API calls, including retries.\n", latency)
+	for _, v := range operations {
+		labels := openMetricsLabels(v)
+		fmt.Fprintf(&b, "%s_count{%s} %d\n", latency, labels, v.Calls)
+		fmt.Fprintf(&b, "%s_sum{%s} %g\n", latency, labels, v.LatencySeconds)
+	}
+
+	b.WriteString("# EOF\n")
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+func openMetricsLabels(v apiOperationMetrics) string {
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+	return fmt.Sprintf(`service="%s",operation="%s"`, escape.Replace(v.Service), escape.Replace(v.Operation))
+}
+
+// addToStack returns a function that adds the API call metrics middleware to an API client's middleware stack.
+// The middleware runs at the start of the Initialize step so that the recorded latency includes all retries.
+func (c *apiMetricsCollector) addToStack(servicePackageName string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(
+			"APIMetrics",
+			func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+				start := time.Now()
+
+				out, metadata, err := next.HandleInitialize(ctx, in)
+
+				var attempts []retry.AttemptResult
+				if v, ok := retry.GetAttemptResults(metadata); ok {
+					attempts = v.Results
+				}
+				c.record(servicePackageName, middleware.GetOperationName(ctx), time.Since(start), err, attempts)
+
+				return out, metadata, err
+			},
+		), middleware.Before)
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	smithy "github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAPIMetricsFilePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     string
+		expected string
+	}{
+		"extension": {
+			path:     "aws-api-metrics.json",
+			expected: "aws-api-metrics.12345.json",
+		},
+		"no extension": {
+			path:     "aws-api-metrics",
+			expected: "aws-api-metrics.12345",
+		},
+		"directory": {
+			path:     "/tmp/metrics.d/aws-api-metrics.txt",
+			expected: "/tmp/metrics.d/aws-api-metrics.12345.txt",
+		},
+		"directory no extension": {
+			path:     "/tmp/metrics.d/aws-api-metrics",
+			expected: "/tmp/metrics.d/aws-api-metrics.12345",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := apiMetricsFilePath(testCase.path, 12345), testCase.expected; got != expected {
+				t.Errorf("got: %s, expected: %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestAPIMetricsCollectorRecord(t *testing.T) {
+	t.Parallel()
+
+	throttle := &smithy.GenericAPIError{Code: "Throttling"}
+	c := newAPIMetricsCollector()
+
+	c.record(names.Route53, "ListHostedZones", time.Second, nil, []retry.AttemptResult{{}})
+	c.record(names.Organizations, "ListAccounts", 2*time.Second, nil, []retry.AttemptResult{{Err: throttle}, {Err: throttle}, {}})
+	c.record(names.Organizations, "ListAccounts", 3*time.Second, throttle, []retry.AttemptResult{{Err: throttle}})
+	c.record(names.Organizations, "DescribeOrganization", time.Second, errors.New("test error"), []retry.AttemptResult{{Err: errors.New("test error")}})
+
+	expected := []apiOperationMetrics{
+		{
+			Service:           names.Organizations,
+			Operation:         "DescribeOrganization",
+			Calls:             1,
+			Errors:            1,
+			LatencySeconds:    1,
+			MaxLatencySeconds: 1,
+		},
+		{
+			Service:           names.Organizations,
+			Operation:         "ListAccounts",
+			Calls:             2,
+			Errors:            1,
+			Throttles:         3,
+			Retries:           2,
+			LatencySeconds:    5,
+			MaxLatencySeconds: 3,
+		},
+		{
+			Service:           names.Route53,
+			Operation:         "ListHostedZones",
+			Calls:             1,
+			LatencySeconds:    1,
+			MaxLatencySeconds: 1,
+		},
+	}
+
+	if diff := cmp.Diff(c.snapshot(), expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAPIMetricsCollectorWrite(t *testing.T) {
+	t.Parallel()
+
+	c := newAPIMetricsCollector()
+	c.record(names.Organizations, "ListAccounts", 1500*time.Millisecond, nil, []retry.AttemptResult{{Err: &smithy.GenericAPIError{Code: "Throttling"}}, {}})
+
+	testCases := map[string]struct {
+		format      string
+		expected    string
+		expectError bool
+	}{
+		"json": {
+			format: apiMetricsFormatJSON,
+			expected: `{
+  "operations": [
+    {
+      "service": "organizations",
+      "operation": "ListAccounts",
+      "calls": 1,
+      "errors": 0,
+      "throttles": 1,
+      "retries": 1,
+      "latency_seconds": 1.5,
+      "max_latency_seconds": 1.5
+    }
+  ]
+}
+`,
+		},
+		"openmetrics": {
+			format: apiMetricsFormatOpenMetrics,
+			expected: `# TYPE tf_aws_api_calls counter
+# HELP tf_aws_api_calls Number of AWS API calls.
+tf_aws_api_calls_total{service="organizations",operation="ListAccounts"} 1
+# TYPE tf_aws_api_errors counter
+# HELP tf_aws_api_errors Number of AWS API calls that failed.
+tf_aws_api_errors_total{service="organizations",operation="ListAccounts"} 0
+# TYPE tf_aws_api_throttles counter
+# HELP tf_aws_api_throttles Number of AWS API call attempts that were throttled.
+tf_aws_api_throttles_total{service="organizations",operation="ListAccounts"} 1
+# TYPE tf_aws_api_retries counter
+# HELP tf_aws_api_retries Number of AWS API call attempts that were retries.
+tf_aws_api_retries_total{service="organizations",operation="ListAccounts"} 1
+# TYPE tf_aws_api_call_duration_seconds summary
+# UNIT tf_aws_api_call_duration_seconds seconds
+# HELP tf_aws_api_call_duration_seconds Time taken by AWS API calls, including retries.
+tf_aws_api_call_duration_seconds_count{service="organizations",operation="ListAccounts"} 1
+tf_aws_api_call_duration_seconds_sum{service="organizations",operation="ListAccounts"} 1.5
+# EOF
+`,
+		},
+		"unsupported": {
+			format:      "xml",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var b strings.Builder
+			err := c.write(&b, testCase.format)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error: %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(b.String(), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAPIMetricsCollectorAddToStack(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// The first request is throttled, the second succeeds.
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error><RequestId>1</RequestId></ErrorResponse>`)
+			return
+		}
+
+		fmt.Fprint(w, `<GetCallerIdentityResponse><GetCallerIdentityResult><Arn>arn:aws:iam::123456789012:user/test</Arn><UserId>AIDATEST</UserId><Account>123456789012</Account></GetCallerIdentityResult><ResponseMetadata><RequestId>2</RequestId></ResponseMetadata></GetCallerIdentityResponse>`)
+	}))
+	t.Cleanup(server.Close)
+
+	c := newAPIMetricsCollector()
+	client := sts.NewFromConfig(aws.Config{
+		APIOptions:  []func(*middleware.Stack) error{c.addToStack(names.STS)},
+		Credentials: credentials.NewStaticCredentialsProvider("AKIATEST", "secret", ""),
+		Region:      "us-west-2", // lintignore:AWSAT003
+		Retryer: func() aws.Retryer {
+			return retry.NewStandard(func(o *retry.StandardOptions) {
+				o.Backoff = retry.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+					return 0, nil
+				})
+			})
+		},
+	}, func(o *sts.Options) {
+		o.BaseEndpoint = aws.String(server.URL)
+	})
+
+	if _, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatal(err)
+	}
+
+	got := c.snapshot()
+	if len(got) != 1 {
+		t.Fatalf("metrics got: %v, expected one operation", got)
+	}
+
+	v := got[0]
+	if v.Service != names.STS || v.Operation != "GetCallerIdentity" {
+		t.Errorf("operation got: %s/%s, expected: %s/GetCallerIdentity", v.Service, v.Operation, names.STS)
+	}
+	if v.Calls != 1 || v.Errors != 0 || v.Throttles != 1 || v.Retries != 1 {
+		t.Errorf("metrics got: %+v, expected 1 call, 0 errors, 1 throttle and 1 retry", v)
+	}
+	if v.LatencySeconds <= 0 {
+		t.Errorf("latency got: %v, expected positive", v.LatencySeconds)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"maps"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
@@ -17,6 +18,7 @@ import (
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -29,8 +31,10 @@ type AWSClient struct {
 	ServicePackages map[string]ServicePackage
 
 	accountID                 string
+	apiRateLimiters           map[string]*apiRateLimiters // From provider configuration.
 	awsConfig                 *aws.Config
 	clients                   map[string]any
+	collectAPIMetrics         bool // From environment.
 	conns                     map[string]any
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
@@ -45,6 +49,7 @@ type AWSClient struct {
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
 	stsRegion                 string // From provider configuration.
+	tagPolicy                 *tftags.TagPolicy
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -63,6 +68,11 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
+// TagPolicy returns the tag policy that resource tags must satisfy, if any.
+func (c *AWSClient) TagPolicy(context.Context) *tftags.TagPolicy {
+	return c.tagPolicy
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
@@ -306,6 +316,19 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 	}
+	var apiOptions []func(*middleware.Stack) error
+	if c.collectAPIMetrics {
+		apiOptions = append(apiOptions, apiMetrics.addToStack(servicePackageName))
+	}
+	if v, ok := c.apiRateLimiters[servicePackageName]; ok {
+		apiOptions = append(apiOptions, v.addToStack)
+	}
+	if len(apiOptions) > 0 {
+		// Each service's API client is constructed from a copy of the AWS SDK for Go v2 configuration, so the additional middleware only applies to this service.
+		cfg := c.awsConfig.Copy()
+		cfg.APIOptions = append(slices.Clone(cfg.APIOptions), apiOptions...)
+		m["aws_sdkv2_config"] = &cfg
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
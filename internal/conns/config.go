@@ -29,6 +29,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APIRateLimits                  []APIRateLimit
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
@@ -36,6 +37,7 @@ type Config struct {
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	EndpointMode                   string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
@@ -43,6 +45,7 @@ type Config struct {
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
+	MockEndpointURL                string
 	NoProxy                        string
 	Profile                        string
 	Region                         string
@@ -57,6 +60,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicy                      *tftags.TagPolicy
 	TerraformVersion               string
 	Token                          string
 	TokenBucketRateLimiterCapacity int
@@ -70,6 +74,15 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
+	if err := c.applyEndpointMode(); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
+	apiRateLimiters, err := newAPIRateLimiters(c.APIRateLimits)
+	if err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
 	const (
 		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
@@ -190,13 +203,17 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		})
 	}
 
+	if c.EndpointMode == EndpointModeMock {
+		accountID = MockAccountID
+	}
+
 	if accountID == "" && !awsbaseConfig.SkipRequestingAccountId {
 		diags = append(diags, errs.NewWarningDiagnostic(
 			"AWS account ID not found for provider",
 			"See https://registry.terraform.io/providers/hashicorp/aws/latest/docs#skip_requesting_account_id for implications."))
 	}
 
-	err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
+	err = awsbaseConfig.VerifyAccountIDAllowed(accountID)
 	if err != nil {
 		return nil, sdkdiag.AppendErrorf(diags, "%s", err.Error())
 	}
@@ -208,15 +225,18 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.accountID = accountID
+	client.apiRateLimiters = apiRateLimiters
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.region = c.Region
+	client.tagPolicy = c.TagPolicy
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
+	client.collectAPIMetrics = apiMetricsEnabled()
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
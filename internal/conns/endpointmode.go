@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// EndpointModeDefault resolves each service's endpoint as normal.
+	EndpointModeDefault = "default"
+	// EndpointModeMock routes every service's API calls to a single local mock endpoint.
+	EndpointModeMock = "mock"
+)
+
+func EndpointMode_Values() []string {
+	return []string{
+		EndpointModeDefault,
+		EndpointModeMock,
+	}
+}
+
+const (
+	// MockAccountID is the AWS account ID used in mock endpoint mode.
+	MockAccountID = "123456789012"
+
+	mockAccessKey = "mock_access_key"
+	mockSecretKey = "mock_secret_key"
+)
+
+// applyEndpointMode adjusts the configuration for the configured endpoint mode.
+// In mock endpoint mode every service without an explicit endpoint is routed to the mock endpoint URL,
+// no calls are made to STS or the EC2 metadata service during configuration, and S3 uses path-style addressing.
+func (c *Config) applyEndpointMode() error {
+	switch c.EndpointMode {
+	case "", EndpointModeDefault:
+		return nil
+	case EndpointModeMock:
+	default:
+		return fmt.Errorf("unsupported endpoint mode: %q", c.EndpointMode)
+	}
+
+	if c.MockEndpointURL == "" {
+		return fmt.Errorf("endpoint mode %q requires a mock endpoint URL", c.EndpointMode)
+	}
+	if u, err := url.Parse(c.MockEndpointURL); err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid mock endpoint URL: %q", c.MockEndpointURL)
+	}
+
+	if c.Endpoints == nil {
+		c.Endpoints = make(map[string]string)
+	}
+	for _, v := range names.ProviderPackages() {
+		if c.Endpoints[v] == "" {
+			c.Endpoints[v] = c.MockEndpointURL
+		}
+	}
+
+	// Use static credentials unless credentials are explicitly configured so that no credential provider calls out.
+	if c.AccessKey == "" && c.SecretKey == "" && c.Profile == "" {
+		c.AccessKey = mockAccessKey
+		c.SecretKey = mockSecretKey
+	}
+
+	// The Region would otherwise be read from the EC2 metadata service.
+	if c.Region == "" {
+		c.Region = endpoints.UsEast1RegionID
+	}
+
+	c.EC2MetadataServiceEnableState = imds.ClientDisabled
+	c.S3UsePathStyle = true
+	c.SkipCredsValidation = true
+	c.SkipRegionValidation = true
+	c.SkipRequestingAccountId = true
+
+	return nil
+}
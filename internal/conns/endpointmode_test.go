@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+)
+
+// TestEndpointModeMock is a conformance test that checks that every service's AWS SDK for Go v2 API client
+// is constructed to call the mock endpoint and that no API calls are made while the provider is configured.
+func TestEndpointModeMock(t *testing.T) {
+	ctx := context.Background()
+
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	p, err := provider.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(map[string]any{
+		"endpoint_mode":     conns.EndpointModeMock,
+		"mock_endpoint_url": server.URL,
+	}))
+	if diags.HasError() {
+		t.Fatalf("configuring provider: %v", diags)
+	}
+
+	if got := requests.Load(); got != 0 {
+		t.Fatalf("requests during provider configuration: %d, expected none", got)
+	}
+
+	meta := p.Meta().(*conns.AWSClient)
+
+	if got, want := meta.AccountID(ctx), conns.MockAccountID; got != want {
+		t.Errorf("AccountID got: %s, expected: %s", got, want)
+	}
+
+	v := reflect.ValueOf(meta)
+	typ := v.Type()
+	contextType := reflect.TypeFor[context.Context]()
+
+	for i := range typ.NumMethod() {
+		method := typ.Method(i)
+		if !strings.HasSuffix(method.Name, "Client") || method.Type.NumIn() != 2 || method.Type.In(1) != contextType || method.Type.NumOut() != 1 {
+			continue
+		}
+		options, ok := method.Type.Out(0).MethodByName("Options")
+		if !ok || options.Type.NumIn() != 1 || options.Type.NumOut() != 1 {
+			continue
+		}
+		if _, ok := options.Type.Out(0).FieldByName("BaseEndpoint"); !ok {
+			continue
+		}
+
+		t.Run(method.Name, func(t *testing.T) {
+			client := v.Method(i).Call([]reflect.Value{reflect.ValueOf(ctx)})[0]
+			o := client.MethodByName("Options").Call(nil)[0]
+
+			if got := aws.ToString(o.FieldByName("BaseEndpoint").Interface().(*string)); got != server.URL {
+				t.Errorf("BaseEndpoint got: %q, expected: %q", got, server.URL)
+			}
+
+			if f := o.FieldByName("UsePathStyle"); f.IsValid() && !f.Bool() {
+				t.Error("UsePathStyle got: false, expected: true")
+			}
+
+			// Make API calls with empty input until one reaches the mock endpoint.
+			// Calls whose input fails client-side validation never leave the client.
+			before := requests.Load()
+			for j := range client.NumMethod() {
+				if !isAPIOperation(client.Type().Method(j).Type, contextType) {
+					continue
+				}
+
+				ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+				client.Method(j).Call([]reflect.Value{reflect.ValueOf(ctx), reflect.New(client.Type().Method(j).Type.In(2).Elem())})
+				cancel()
+
+				if requests.Load() > before {
+					return
+				}
+			}
+
+			t.Error("no API call reached the mock endpoint")
+		})
+	}
+}
+
+// isAPIOperation returns whether the method type is that of an AWS SDK for Go v2 API operation,
+// func(*Client, context.Context, *Input, ...func(*Options)) (*Output, error).
+func isAPIOperation(typ reflect.Type, contextType reflect.Type) bool {
+	return typ.NumIn() == 4 && typ.IsVariadic() && typ.In(1) == contextType && typ.In(2).Kind() == reflect.Pointer && typ.In(2).Elem().Kind() == reflect.Struct &&
+		typ.NumOut() == 2 && typ.Out(1) == reflect.TypeFor[error]()
+}
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// APIRateLimit configures client-side rate limiting of a service's API calls.
+// If Operation is empty the limit applies to all of the service's operations that don't have their own limit.
+type APIRateLimit struct {
+	Service           string
+	Operation         string
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// apiRateLimiters are the rate limiters for one service.
+type apiRateLimiters struct {
+	servicePackageName string
+	service            *tokenBucket
+	operations         map[string]*tokenBucket
+}
+
+// newAPIRateLimiters returns the rate limiters for each configured service, keyed by service package name.
+func newAPIRateLimiters(limits []APIRateLimit) (map[string]*apiRateLimiters, error) {
+	rateLimiters := make(map[string]*apiRateLimiters)
+
+	for _, limit := range limits {
+		if limit.RequestsPerSecond <= 0 {
+			return nil, fmt.Errorf("API rate limit (%s): requests per second must be positive, got %v", limit, limit.RequestsPerSecond)
+		}
+
+		v, ok := rateLimiters[limit.Service]
+		if !ok {
+			v = &apiRateLimiters{
+				servicePackageName: limit.Service,
+				operations:         make(map[string]*tokenBucket),
+			}
+			rateLimiters[limit.Service] = v
+		}
+
+		bucket := newTokenBucket(limit.RequestsPerSecond, limit.Burst)
+
+		if limit.Operation == "" {
+			if v.service != nil {
+				return nil, fmt.Errorf("API rate limit (%s): duplicate limit", limit)
+			}
+			v.service = bucket
+		} else {
+			if _, ok := v.operations[limit.Operation]; ok {
+				return nil, fmt.Errorf("API rate limit (%s): duplicate limit", limit)
+			}
+			v.operations[limit.Operation] = bucket
+		}
+	}
+
+	return rateLimiters, nil
+}
+
+func (l APIRateLimit) String() string {
+	if l.Operation == "" {
+		return l.Service
+	}
+
+	return l.Service + "/" + l.Operation
+}
+
+// limiter returns the rate limiter for the specified operation, or nil if the operation is not rate limited.
+func (l *apiRateLimiters) limiter(operation string) *tokenBucket {
+	if v, ok := l.operations[operation]; ok {
+		return v
+	}
+
+	return l.service
+}
+
+// addToStack adds the rate limiting middleware to an API client's middleware stack.
+// The middleware runs after the retry middleware so that each attempt, including retries, is rate limited.
+// Presigning clears the Finalize step, removing the retry middleware, in which case the middleware is added at the end of the step.
+func (l *apiRateLimiters) addToStack(stack *middleware.Stack) error {
+	m := middleware.FinalizeMiddlewareFunc(
+		"APIRateLimit",
+		func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			operation := middleware.GetOperationName(ctx)
+
+			if bucket := l.limiter(operation); bucket != nil {
+				delay, err := bucket.wait(ctx)
+
+				if delay > 0 {
+					tflog.Debug(ctx, "API call throttled by client-side rate limit", map[string]any{
+						"tf_aws.service_package": l.servicePackageName,
+						"rpc.method":             operation,
+						"delay":                  delay.String(),
+					})
+				}
+
+				if err != nil {
+					return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+				}
+			}
+
+			return next.HandleFinalize(ctx, in)
+		},
+	)
+
+	retryID := (*retry.Attempt)(nil).ID()
+	if _, ok := stack.Finalize.Get(retryID); ok {
+		return stack.Finalize.Insert(m, retryID, middleware.After)
+	}
+
+	return stack.Finalize.Add(m, middleware.After)
+}
+
+// tokenBucket is a token bucket rate limiter.
+// Tokens are added at a fixed rate up to the bucket's capacity (its burst) and each request takes one token.
+type tokenBucket struct {
+	lock   sync.Mutex
+	rate   float64 // Tokens per second.
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// newTokenBucket returns a full token bucket.
+// If burst is not positive the bucket's capacity is one second's worth of tokens, but at least one.
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	capacity := float64(burst)
+	if capacity <= 0 {
+		capacity = math.Max(1, math.Ceil(rate))
+	}
+
+	return &tokenBucket{
+		rate:   rate,
+		burst:  capacity,
+		tokens: capacity,
+		now:    time.Now,
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it.
+// The bucket's token count goes negative while callers are waiting, so that waiting callers are served in order.
+func (b *tokenBucket) reserve() time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a token that was reserved but not used.
+func (b *tokenBucket) cancel() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
+
+// wait blocks until a token is available or the context is done, returning how long the caller was asked to wait.
+func (b *tokenBucket) wait(ctx context.Context) (time.Duration, error) {
+	delay := b.reserve()
+	if delay == 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return delay, nil
+	case <-ctx.Done():
+		b.cancel()
+		return delay, ctx.Err()
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNewAPIRateLimiters(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		limits      []APIRateLimit
+		expectError bool
+	}{
+		"none": {},
+		"service and operation": {
+			limits: []APIRateLimit{
+				{Service: names.Organizations, RequestsPerSecond: 2},
+				{Service: names.Organizations, Operation: "ListAccounts", RequestsPerSecond: 1, Burst: 1},
+				{Service: names.Route53, RequestsPerSecond: 5},
+			},
+		},
+		"zero rate": {
+			limits: []APIRateLimit{
+				{Service: names.Organizations},
+			},
+			expectError: true,
+		},
+		"duplicate service": {
+			limits: []APIRateLimit{
+				{Service: names.Organizations, RequestsPerSecond: 2},
+				{Service: names.Organizations, RequestsPerSecond: 3},
+			},
+			expectError: true,
+		},
+		"duplicate operation": {
+			limits: []APIRateLimit{
+				{Service: names.SSOAdmin, Operation: "CreateAccountAssignment", RequestsPerSecond: 2},
+				{Service: names.SSOAdmin, Operation: "CreateAccountAssignment", RequestsPerSecond: 3},
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := newAPIRateLimiters(testCase.limits)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error: %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			for _, limit := range testCase.limits {
+				v, ok := got[limit.Service]
+				if !ok {
+					t.Fatalf("no rate limiters for %s", limit.Service)
+				}
+				if v.limiter(limit.Operation) == nil {
+					t.Errorf("no rate limiter for %s", limit)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIRateLimitersLimiter(t *testing.T) {
+	t.Parallel()
+
+	rateLimiters, err := newAPIRateLimiters([]APIRateLimit{
+		{Service: names.Organizations, RequestsPerSecond: 2},
+		{Service: names.Organizations, Operation: "ListAccounts", RequestsPerSecond: 1},
+		{Service: names.Route53, Operation: "ChangeResourceRecordSets", RequestsPerSecond: 5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	organizations := rateLimiters[names.Organizations]
+	if organizations.limiter("ListAccounts") == organizations.limiter("DescribeOrganization") {
+		t.Error("operation uses service rate limiter")
+	}
+	if organizations.limiter("DescribeOrganization") != organizations.limiter("ListRoots") {
+		t.Error("operations use different service rate limiters")
+	}
+
+	route53 := rateLimiters[names.Route53]
+	if route53.limiter("ListHostedZones") != nil {
+		t.Error("operation without limit is rate limited")
+	}
+}
+
+func TestAPIRateLimitersAddToStack(t *testing.T) {
+	t.Parallel()
+
+	rateLimiters, err := newAPIRateLimiters([]APIRateLimit{
+		{Service: names.Organizations, RequestsPerSecond: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+	if err := stack.Finalize.Add(retry.NewAttemptMiddleware(retry.AddWithMaxAttempts(retry.NewStandard(), 1), nil), middleware.After); err != nil {
+		t.Fatal(err)
+	}
+	if err := stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("Signing", nil), middleware.After); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rateLimiters[names.Organizations].addToStack(stack); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(stack.Finalize.List(), []string{"Retry", "APIRateLimit", "Signing"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAPIRateLimitersAddToStackPresign(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	rateLimiters, err := newAPIRateLimiters([]APIRateLimit{
+		{Service: names.STS, RequestsPerSecond: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Presigning clears the Finalize step, removing the retry middleware, before API options are applied.
+	client := sts.NewPresignClient(sts.NewFromConfig(aws.Config{
+		APIOptions:  []func(*middleware.Stack) error{rateLimiters[names.STS].addToStack},
+		Credentials: credentials.NewStaticCredentialsProvider("AKIATEST", "secret", ""),
+		Region:      "us-west-2", // lintignore:AWSAT003
+	}))
+
+	output, err := client.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if output.URL == "" {
+		t.Error("expected presigned URL")
+	}
+}
+
+func TestTokenBucketReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := newTokenBucket(2, 2)
+	b.now = func() time.Time { return now }
+
+	var got []time.Duration
+	for range 4 {
+		got = append(got, b.reserve())
+	}
+
+	// Two calls in the burst, then one every half second.
+	expected := []time.Duration{0, 0, 500 * time.Millisecond, time.Second}
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// After the waiting calls have been served the bucket refills, up to its burst.
+	now = now.Add(10 * time.Second)
+	got = nil
+	for range 3 {
+		got = append(got, b.reserve())
+	}
+
+	expected = []time.Duration{0, 0, 500 * time.Millisecond}
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestTokenBucketDefaultBurst(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rate     float64
+		expected float64
+	}{
+		"fractional": {
+			rate:     0.5,
+			expected: 1,
+		},
+		"integral": {
+			rate:     5,
+			expected: 5,
+		},
+		"rounded up": {
+			rate:     2.5,
+			expected: 3,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := newTokenBucket(testCase.rate, 0).burst, testCase.expected; got != want {
+				t.Errorf("burst got: %v, expected: %v", got, want)
+			}
+		})
+	}
+}
+
+func TestTokenBucketWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	b := newTokenBucket(0.001, 1)
+	if _, err := b.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	delay, err := b.wait(ctx)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if delay <= 0 {
+		t.Errorf("delay got: %s, expected a wait", delay)
+	}
+
+	// The canceled call's token is returned to the bucket.
+	if b.tokens < -0.001 {
+		t.Errorf("tokens got: %v, expected the canceled reservation to be returned", b.tokens)
+	}
+}
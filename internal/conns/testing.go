@@ -4,6 +4,8 @@
 package conns
 
 import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
@@ -16,3 +18,16 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// AddAWSConfigIsErrorRetryables is only intended for use in tests
+// It must be called before any AWS SDK for Go v2 API clients are created.
+func AddAWSConfigIsErrorRetryables(client *AWSClient, retryables ...retry.IsErrorRetryable) {
+	if client.awsConfig == nil || client.awsConfig.Retryer == nil {
+		return
+	}
+
+	newRetryer := client.awsConfig.Retryer
+	client.awsConfig.Retryer = func() aws.Retryer {
+		return AddIsErrorRetryables(newRetryer().(aws.RetryerV2), retryables...)
+	}
+}
@@ -101,6 +101,15 @@ func autoExpandConvert(ctx context.Context, from, to any, flexer autoFlexer) dia
 		}
 	}
 
+	// Top-level struct to union conversion.
+	if valFrom.IsValid() && valTo.IsValid() && valFrom.Kind() == reflect.Struct {
+		if _, ok := lookupUnionType(valTo.Type()); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Converting")
+			diags.Append(autoFlexConvertStruct(ctx, sourcePath, from, targetPath, to, flexer)...)
+			return diags
+		}
+	}
+
 	// Anything else.
 	diags.Append(flexer.convert(ctx, sourcePath, valFrom, targetPath, valTo, fieldOpts{})...)
 	return diags
@@ -124,6 +133,18 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 
 	tflog.SubsystemInfo(ctx, subsystemName, "Converting")
 
+	if fieldOpts.converter != "" {
+		vFrom, ok := valFrom.Interface().(attr.Value)
+		if !ok {
+			tflog.SubsystemError(ctx, subsystemName, "Source does not implement attr.Value")
+			diags.Append(diagExpandingSourceDoesNotImplementAttrValue(reflect.TypeOf(valFrom.Interface())))
+			return diags
+		}
+
+		diags.Append(expandFieldConverter(ctx, fieldOpts.converter, vFrom, vTo)...)
+		return diags
+	}
+
 	if fromExpander, ok := valFrom.Interface().(Expander); ok {
 		tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.Expander")
 		diags.Append(expandExpander(ctx, fromExpander, vTo)...)
@@ -485,6 +506,29 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 		}
 
 	case reflect.Interface:
+		//
+		// fwtypes.SmithyDocument -> Smithy document.
+		//
+		if s, ok := vFrom.(fwtypes.SmithyDocumentValuable); ok {
+			v, d := s.ToSmithyDocument(ctx)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if v == nil {
+				return diags
+			}
+
+			if t := reflect.TypeOf(v); !t.AssignableTo(tTo) {
+				diags.Append(diagCannotBeAssigned(t, tTo))
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(v))
+			return diags
+		}
+
 		if s, ok := vFrom.(fwtypes.SmithyJSON[smithyjson.JSONStringer]); ok {
 			v, d := s.ValueInterface()
 			diags.Append(d...)
@@ -508,6 +552,9 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 					return diags
 				}
 			}
+			if fieldOpts.omitempty && len(v.ValueString()) == 0 {
+				return diags
+			}
 			vTo.Set(reflect.ValueOf(v.ValueStringPointer()))
 			return diags
 
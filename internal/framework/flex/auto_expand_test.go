@@ -562,6 +562,36 @@ func TestExpand(t *testing.T) {
 				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.SmithyJSON[smithyjson.JSONStringer]](), "Field1", reflect.TypeFor[smithyjson.JSONStringer]()),
 			},
 		},
+		"SmithyDocument Source to json interface Target": {
+			Source: &tfSmithyDocument{Field1: fwtypes.SmithyDocumentValue(`{"field1": 1.0, "field2": ["a"]}`, newTestJSONDocument)},
+			Target: &awsJSONStringer{},
+			WantTarget: &awsJSONStringer{
+				Field1: &testJSONDocument{
+					Value: map[string]any{
+						"field1": float64(1),
+						"field2": []any{"a"},
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSmithyDocument](), reflect.TypeFor[*awsJSONStringer]()),
+				infoConverting(reflect.TypeFor[tfSmithyDocument](), reflect.TypeFor[*awsJSONStringer]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSmithyDocument](), "Field1", reflect.TypeFor[*awsJSONStringer]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.SmithyDocument[smithyjson.JSONStringer]](), "Field1", reflect.TypeFor[smithyjson.JSONStringer]()),
+			},
+		},
+		"null SmithyDocument Source to json interface Target": {
+			Source:     &tfSmithyDocument{Field1: fwtypes.SmithyDocumentNull[smithyjson.JSONStringer]()},
+			Target:     &awsJSONStringer{},
+			WantTarget: &awsJSONStringer{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSmithyDocument](), reflect.TypeFor[*awsJSONStringer]()),
+				infoConverting(reflect.TypeFor[tfSmithyDocument](), reflect.TypeFor[*awsJSONStringer]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSmithyDocument](), "Field1", reflect.TypeFor[*awsJSONStringer]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.SmithyDocument[smithyjson.JSONStringer]](), "Field1", reflect.TypeFor[smithyjson.JSONStringer]()),
+				traceExpandingNullValue("Field1", reflect.TypeFor[fwtypes.SmithyDocument[smithyjson.JSONStringer]](), "Field1", reflect.TypeFor[smithyjson.JSONStringer]()),
+			},
+		},
 	}
 
 	runAutoExpandTestCases(t, testCases)
@@ -4607,6 +4637,257 @@ func TestExpandIgnoreStructTag(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestExpandNameStructTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"name override": {
+			Source: &tfNameOverride{
+				IPv4Address: types.StringValue("10.0.0.1"),
+			},
+			Target: &awsNameOverride{},
+			WantTarget: &awsNameOverride{
+				PrivateIpAddress: aws.String("10.0.0.1"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfNameOverride](), reflect.TypeFor[*awsNameOverride]()),
+				infoConverting(reflect.TypeFor[tfNameOverride](), reflect.TypeFor[*awsNameOverride]()),
+				traceMatchedFields("IPv4Address", reflect.TypeFor[tfNameOverride](), "PrivateIpAddress", reflect.TypeFor[*awsNameOverride]()),
+				infoConvertingWithPath("IPv4Address", reflect.TypeFor[types.String](), "PrivateIpAddress", reflect.TypeFor[*string]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandOmitEmptyStructTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"value": {
+			Source: &tfSingleStringFieldOmitEmpty{
+				Field1: types.StringValue("a"),
+			},
+			Target: &awsSingleStringPointer{},
+			WantTarget: &awsSingleStringPointer{
+				Field1: aws.String("a"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSingleStringFieldOmitEmpty](), reflect.TypeFor[*awsSingleStringPointer]()),
+				infoConverting(reflect.TypeFor[tfSingleStringFieldOmitEmpty](), reflect.TypeFor[*awsSingleStringPointer]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSingleStringFieldOmitEmpty](), "Field1", reflect.TypeFor[*awsSingleStringPointer]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[*string]()),
+			},
+		},
+		"zero": {
+			Source: &tfSingleStringFieldOmitEmpty{
+				Field1: types.StringValue(""),
+			},
+			Target:     &awsSingleStringPointer{},
+			WantTarget: &awsSingleStringPointer{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfSingleStringFieldOmitEmpty](), reflect.TypeFor[*awsSingleStringPointer]()),
+				infoConverting(reflect.TypeFor[tfSingleStringFieldOmitEmpty](), reflect.TypeFor[*awsSingleStringPointer]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfSingleStringFieldOmitEmpty](), "Field1", reflect.TypeFor[*awsSingleStringPointer]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[*string]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandFieldConverter(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"value": {
+			Source: &tfFieldConverter{
+				Field1: types.StringValue("a,b"),
+			},
+			Target: &awsFieldConverter{},
+			WantTarget: &awsFieldConverter{
+				Field1: []string{"a", "b"},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfFieldConverter](), reflect.TypeFor[*awsFieldConverter]()),
+				infoConverting(reflect.TypeFor[tfFieldConverter](), reflect.TypeFor[*awsFieldConverter]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfFieldConverter](), "Field1", reflect.TypeFor[*awsFieldConverter]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[[]string]()),
+				infoUsingFieldConverter("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[[]string](), "testCSV"),
+			},
+		},
+		"null": {
+			Source: &tfFieldConverter{
+				Field1: types.StringNull(),
+			},
+			Target:     &awsFieldConverter{},
+			WantTarget: &awsFieldConverter{},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfFieldConverter](), reflect.TypeFor[*awsFieldConverter]()),
+				infoConverting(reflect.TypeFor[tfFieldConverter](), reflect.TypeFor[*awsFieldConverter]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfFieldConverter](), "Field1", reflect.TypeFor[*awsFieldConverter]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[[]string]()),
+				infoUsingFieldConverter("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[[]string](), "testCSV"),
+			},
+		},
+		"not registered": {
+			Source: &tfFieldConverterNotRegistered{
+				Field1: types.StringValue("a,b"),
+			},
+			Target: &awsFieldConverter{},
+			expectedDiags: diag.Diagnostics{
+				diagFieldConverterNotRegistered("testNotRegistered"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfFieldConverterNotRegistered](), reflect.TypeFor[*awsFieldConverter]()),
+				infoConverting(reflect.TypeFor[tfFieldConverterNotRegistered](), reflect.TypeFor[*awsFieldConverter]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfFieldConverterNotRegistered](), "Field1", reflect.TypeFor[*awsFieldConverter]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[[]string]()),
+				errorFieldConverterNotRegistered("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[[]string](), "testNotRegistered"),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandReportUnmatchedFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"report": {
+			Options: []AutoFlexOptionsFunc{WithReportUnmatchedFields()},
+			Source: &tfReportUnmatchedFields{
+				Field1: types.StringValue("a"),
+				Field2: types.StringValue("b"),
+			},
+			Target: &awsReportUnmatchedFields{},
+			WantTarget: &awsReportUnmatchedFields{
+				Field1: aws.String("a"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfReportUnmatchedFields](), reflect.TypeFor[*awsReportUnmatchedFields]()),
+				infoConverting(reflect.TypeFor[tfReportUnmatchedFields](), reflect.TypeFor[*awsReportUnmatchedFields]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfReportUnmatchedFields](), "Field1", reflect.TypeFor[*awsReportUnmatchedFields]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[*string]()),
+				debugNoCorrespondingField(reflect.TypeFor[tfReportUnmatchedFields](), "Field2", reflect.TypeFor[*awsReportUnmatchedFields]()),
+				warnUnmatchedSourceField(reflect.TypeFor[tfReportUnmatchedFields](), "Field2", reflect.TypeFor[*awsReportUnmatchedFields]()),
+				warnUnmatchedTargetField(reflect.TypeFor[tfReportUnmatchedFields](), reflect.TypeFor[*awsReportUnmatchedFields](), "Field3"),
+			},
+		},
+		"no report": {
+			Source: &tfReportUnmatchedFields{
+				Field1: types.StringValue("a"),
+				Field2: types.StringValue("b"),
+			},
+			Target: &awsReportUnmatchedFields{},
+			WantTarget: &awsReportUnmatchedFields{
+				Field1: aws.String("a"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfReportUnmatchedFields](), reflect.TypeFor[*awsReportUnmatchedFields]()),
+				infoConverting(reflect.TypeFor[tfReportUnmatchedFields](), reflect.TypeFor[*awsReportUnmatchedFields]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfReportUnmatchedFields](), "Field1", reflect.TypeFor[*awsReportUnmatchedFields]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[types.String](), "Field1", reflect.TypeFor[*string]()),
+				debugNoCorrespondingField(reflect.TypeFor[tfReportUnmatchedFields](), "Field2", reflect.TypeFor[*awsReportUnmatchedFields]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"primitive member": {
+			Source: &tfUnion{
+				String: types.StringValue("a"),
+				Nested: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+			},
+			Target:     testUnionPtr(nil),
+			WantTarget: testUnionPtr(&awsUnionMemberString{Value: "a"}),
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfUnion](), reflect.TypeFor[*awsUnion]()),
+				infoConverting(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUnion]()),
+				infoExpandingUnion("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[*awsUnion]()),
+				traceExpandingMatchedUnionMember("", reflect.TypeFor[tfUnion](), "String", "", reflect.TypeFor[*awsUnion](), "String"),
+				infoConvertingWithPath("String", reflect.TypeFor[types.String](), "String", reflect.TypeFor[string]()),
+			},
+		},
+		"no member set": {
+			Source: &tfUnion{
+				String: types.StringNull(),
+				Nested: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+			},
+			Target:     testUnionPtr(nil),
+			WantTarget: testUnionPtr(nil),
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfUnion](), reflect.TypeFor[*awsUnion]()),
+				infoConverting(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUnion]()),
+				infoExpandingUnion("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[*awsUnion]()),
+				traceNoUnionMemberSet("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[*awsUnion]()),
+			},
+		},
+		"multiple members set": {
+			Source: &tfUnion{
+				String: types.StringValue("a"),
+				Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+					Field1: types.StringValue("b"),
+				}),
+			},
+			Target: testUnionPtr(nil),
+			expectedDiags: diag.Diagnostics{
+				diagExpandingMultipleUnionMembers(reflect.TypeFor[tfUnion](), "String", "Nested"),
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfUnion](), reflect.TypeFor[*awsUnion]()),
+				infoConverting(reflect.TypeFor[tfUnion](), reflect.TypeFor[*awsUnion]()),
+				infoExpandingUnion("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[*awsUnion]()),
+				traceExpandingMatchedUnionMember("", reflect.TypeFor[tfUnion](), "String", "", reflect.TypeFor[*awsUnion](), "String"),
+				infoConvertingWithPath("String", reflect.TypeFor[types.String](), "String", reflect.TypeFor[string]()),
+				errorMultipleUnionMembersSet("", reflect.TypeFor[tfUnion](), "", reflect.TypeFor[*awsUnion]()),
+			},
+		},
+		"nested member": {
+			Source: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					String: types.StringNull(),
+					Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("a"),
+					}),
+				}),
+			},
+			Target: &awsUnionField{},
+			WantTarget: &awsUnionField{
+				Field1: &awsUnionMemberNested{
+					Value: awsSingleStringValue{
+						Field1: "a",
+					},
+				},
+			},
+			expectedLogLines: []map[string]any{
+				infoExpanding(reflect.TypeFor[*tfUnionField](), reflect.TypeFor[*awsUnionField]()),
+				infoConverting(reflect.TypeFor[tfUnionField](), reflect.TypeFor[*awsUnionField]()),
+				traceMatchedFields("Field1", reflect.TypeFor[tfUnionField](), "Field1", reflect.TypeFor[*awsUnionField]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]](), "Field1", reflect.TypeFor[awsUnion]()),
+				infoExpandingUnion("Field1[0]", reflect.TypeFor[tfUnion](), "Field1", reflect.TypeFor[*awsUnion]()),
+				traceExpandingMatchedUnionMember("Field1[0]", reflect.TypeFor[tfUnion](), "Nested", "Field1", reflect.TypeFor[*awsUnion](), "Nested"),
+				infoConvertingWithPath("Field1[0].Nested", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]](), "Field1.Nested", reflect.TypeFor[awsSingleStringValue]()),
+				traceMatchedFieldsWithPath("Field1[0].Nested[0]", "Field1", reflect.TypeFor[tfSingleStringField](), "Field1.Nested", "Field1", reflect.TypeFor[*awsSingleStringValue]()),
+				infoConvertingWithPath("Field1[0].Nested[0].Field1", reflect.TypeFor[types.String](), "Field1.Nested.Field1", reflect.TypeFor[string]()),
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases)
+}
+
 func TestExpandInterface(t *testing.T) {
 	t.Parallel()
 
@@ -145,6 +145,11 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 
 	tflog.SubsystemInfo(ctx, subsystemName, "Converting")
 
+	if fieldOpts.converter != "" {
+		diags.Append(flattenFieldConverter(ctx, fieldOpts.converter, vFrom, vTo)...)
+		return diags
+	}
+
 	// main control flow
 	tTo := valTo.Type(ctx)
 	switch k := vFrom.Kind(); k {
@@ -190,7 +195,7 @@ func (flattener autoFlattener) convert(ctx context.Context, sourcePath path.Path
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattener.interface_(ctx, vFrom, tTo, vTo)...)
+		diags.Append(flattener.interface_(ctx, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -609,7 +614,7 @@ func (flattener autoFlattener) pointer(ctx context.Context, sourcePath path.Path
 	return diags
 }
 
-func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interface_(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -653,7 +658,7 @@ func (flattener autoFlattener) interface_(ctx context.Context, vFrom reflect.Val
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, vFrom.IsNil(), tTo, vTo)...)
+		diags.Append(flattener.interfaceToNestedObject(ctx, sourcePath, vFrom, vFrom.IsNil(), targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1215,7 +1220,7 @@ func (flattener autoFlattener) structToNestedObject(ctx context.Context, sourceP
 }
 
 // interfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -1237,6 +1242,24 @@ func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFro
 
 	toFlattener, ok := to.(Flattener)
 	if !ok {
+		if _, ok := lookupUnionType(vFrom.Type()); ok {
+			// Create a new target structure and walk the union variant.
+			diags.Append(autoFlexConvertStruct(ctx, sourcePath, vFrom.Interface(), targetPath, to, flattener)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			// Set the target structure as a mapped Object.
+			val, d := tTo.ValueFromObjectPtr(ctx, to)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(val))
+			return diags
+		}
+
 		val, d := tTo.NullValue(ctx)
 		diags.Append(d...)
 		if diags.HasError() {
@@ -4335,6 +4335,168 @@ func TestFlattenIgnoreStructTag(t *testing.T) {
 	runAutoExpandTestCases(t, testCases)
 }
 
+func TestFlattenNameStructTag(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"name override": {
+			Source: awsNameOverride{
+				Ipv4Address:      aws.String("10.0.0.1"),
+				PrivateIpAddress: aws.String("10.0.0.2"),
+			},
+			Target: &tfNameOverride{},
+			WantTarget: &tfNameOverride{
+				IPv4Address: types.StringValue("10.0.0.2"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsNameOverride](), reflect.TypeFor[*tfNameOverride]()),
+				infoConverting(reflect.TypeFor[awsNameOverride](), reflect.TypeFor[*tfNameOverride]()),
+				debugNoCorrespondingField(reflect.TypeFor[awsNameOverride](), "Ipv4Address", reflect.TypeFor[*tfNameOverride]()),
+				traceMatchedFields("PrivateIpAddress", reflect.TypeFor[awsNameOverride](), "IPv4Address", reflect.TypeFor[*tfNameOverride]()),
+				infoConvertingWithPath("PrivateIpAddress", reflect.TypeFor[*string](), "IPv4Address", reflect.TypeFor[types.String]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenFieldConverter(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"value": {
+			Source: awsFieldConverter{
+				Field1: []string{"a", "b"},
+			},
+			Target: &tfFieldConverter{},
+			WantTarget: &tfFieldConverter{
+				Field1: types.StringValue("a,b"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsFieldConverter](), reflect.TypeFor[*tfFieldConverter]()),
+				infoConverting(reflect.TypeFor[awsFieldConverter](), reflect.TypeFor[*tfFieldConverter]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsFieldConverter](), "Field1", reflect.TypeFor[*tfFieldConverter]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[[]string](), "Field1", reflect.TypeFor[types.String]()),
+				infoUsingFieldConverter("Field1", reflect.TypeFor[[]string](), "Field1", reflect.TypeFor[types.String](), "testCSV"),
+			},
+		},
+		"nil": {
+			Source: awsFieldConverter{},
+			Target: &tfFieldConverter{},
+			WantTarget: &tfFieldConverter{
+				Field1: types.StringNull(),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsFieldConverter](), reflect.TypeFor[*tfFieldConverter]()),
+				infoConverting(reflect.TypeFor[awsFieldConverter](), reflect.TypeFor[*tfFieldConverter]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsFieldConverter](), "Field1", reflect.TypeFor[*tfFieldConverter]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[[]string](), "Field1", reflect.TypeFor[types.String]()),
+				infoUsingFieldConverter("Field1", reflect.TypeFor[[]string](), "Field1", reflect.TypeFor[types.String](), "testCSV"),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenReportUnmatchedFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := autoFlexTestCases{
+		"report": {
+			Options: []AutoFlexOptionsFunc{WithReportUnmatchedFields()},
+			Source: awsReportUnmatchedFields{
+				Field1: aws.String("a"),
+				Field3: aws.String("c"),
+			},
+			Target: &tfReportUnmatchedFields{},
+			WantTarget: &tfReportUnmatchedFields{
+				Field1: types.StringValue("a"),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsReportUnmatchedFields](), reflect.TypeFor[*tfReportUnmatchedFields]()),
+				infoConverting(reflect.TypeFor[awsReportUnmatchedFields](), reflect.TypeFor[*tfReportUnmatchedFields]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsReportUnmatchedFields](), "Field1", reflect.TypeFor[*tfReportUnmatchedFields]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[*string](), "Field1", reflect.TypeFor[types.String]()),
+				debugNoCorrespondingField(reflect.TypeFor[awsReportUnmatchedFields](), "Field3", reflect.TypeFor[*tfReportUnmatchedFields]()),
+				warnUnmatchedSourceField(reflect.TypeFor[awsReportUnmatchedFields](), "Field3", reflect.TypeFor[*tfReportUnmatchedFields]()),
+				warnUnmatchedTargetField(reflect.TypeFor[awsReportUnmatchedFields](), reflect.TypeFor[*tfReportUnmatchedFields](), "Field2"),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"primitive member": {
+			Source: &awsUnionMemberString{Value: "a"},
+			Target: &tfUnion{},
+			WantTarget: &tfUnion{
+				String: types.StringValue("a"),
+				Nested: fwtypes.NewListNestedObjectValueOfNull[tfSingleStringField](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsUnionMemberString](), reflect.TypeFor[*tfUnion]()),
+				infoConverting(reflect.TypeFor[awsUnionMemberString](), reflect.TypeFor[*tfUnion]()),
+				infoFlatteningUnion("", reflect.TypeFor[awsUnionMemberString](), "", reflect.TypeFor[*tfUnion](), "String"),
+				traceFlatteningMatchedUnionMember("", reflect.TypeFor[awsUnionMemberString](), "String", "", reflect.TypeFor[*tfUnion](), "String"),
+				infoConvertingWithPath("Value", reflect.TypeFor[string](), "String", reflect.TypeFor[types.String]()),
+			},
+		},
+		"nested member": {
+			Source: awsUnionField{
+				Field1: &awsUnionMemberNested{
+					Value: awsSingleStringValue{
+						Field1: "a",
+					},
+				},
+			},
+			Target: &tfUnionField{},
+			WantTarget: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnion{
+					String: types.StringNull(),
+					Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfSingleStringField{
+						Field1: types.StringValue("a"),
+					}),
+				}),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionField](), reflect.TypeFor[*tfUnionField]()),
+				infoConverting(reflect.TypeFor[awsUnionField](), reflect.TypeFor[*tfUnionField]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionField](), "Field1", reflect.TypeFor[*tfUnionField]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+				infoFlatteningUnion("Field1", reflect.TypeFor[awsUnionMemberNested](), "Field1", reflect.TypeFor[*tfUnion](), "Nested"),
+				traceFlatteningMatchedUnionMember("Field1", reflect.TypeFor[awsUnionMemberNested](), "Nested", "Field1", reflect.TypeFor[*tfUnion](), "Nested"),
+				infoConvertingWithPath("Field1.Value", reflect.TypeFor[awsSingleStringValue](), "Field1.Nested", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfSingleStringField]]()),
+				traceMatchedFieldsWithPath("Field1.Value", "Field1", reflect.TypeFor[awsSingleStringValue](), "Field1.Nested", "Field1", reflect.TypeFor[*tfSingleStringField]()),
+				infoConvertingWithPath("Field1.Value.Field1", reflect.TypeFor[string](), "Field1.Nested.Field1", reflect.TypeFor[types.String]()),
+			},
+		},
+		"nil": {
+			Source: awsUnionField{},
+			Target: &tfUnionField{},
+			WantTarget: &tfUnionField{
+				Field1: fwtypes.NewListNestedObjectValueOfNull[tfUnion](ctx),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[awsUnionField](), reflect.TypeFor[*tfUnionField]()),
+				infoConverting(reflect.TypeFor[awsUnionField](), reflect.TypeFor[*tfUnionField]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsUnionField](), "Field1", reflect.TypeFor[*tfUnionField]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[awsUnion](), "Field1", reflect.TypeFor[fwtypes.ListNestedObjectValueOf[tfUnion]]()),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases)
+}
+
 func TestFlattenInterfaceToStringTypable(t *testing.T) {
 	t.Parallel()
 
@@ -4423,6 +4585,44 @@ func TestFlattenInterfaceToStringTypable(t *testing.T) {
 			},
 		},
 
+		"json interface Source SmithyDocument Target": {
+			Source: &awsJSONStringer{
+				Field1: &testJSONDocument{
+					Value: map[string]any{
+						"test": []any{"a", 1},
+					},
+				},
+			},
+			Target: &tfSmithyDocument{},
+			WantTarget: &tfSmithyDocument{
+				Field1: fwtypes.SmithyDocumentValue(`{"test":["a",1]}`, newTestJSONDocument),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsJSONStringer](), reflect.TypeFor[*tfSmithyDocument]()),
+				infoConverting(reflect.TypeFor[awsJSONStringer](), reflect.TypeFor[*tfSmithyDocument]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsJSONStringer](), "Field1", reflect.TypeFor[*tfSmithyDocument]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[smithyjson.JSONStringer](), "Field1", reflect.TypeFor[fwtypes.SmithyDocument[smithyjson.JSONStringer]]()),
+				infoSourceImplementsJSONStringer("Field1", reflect.TypeFor[smithyjson.JSONStringer](), "Field1", reflect.TypeFor[fwtypes.SmithyDocument[smithyjson.JSONStringer]]()), // TODO: fix source type
+			},
+		},
+		"null json interface Source SmithyDocument Target": {
+			Source: &awsJSONStringer{
+				Field1: nil,
+			},
+			Target: &tfSmithyDocument{},
+			WantTarget: &tfSmithyDocument{
+				Field1: fwtypes.SmithyDocumentNull[smithyjson.JSONStringer](),
+			},
+			expectedLogLines: []map[string]any{
+				infoFlattening(reflect.TypeFor[*awsJSONStringer](), reflect.TypeFor[*tfSmithyDocument]()),
+				infoConverting(reflect.TypeFor[awsJSONStringer](), reflect.TypeFor[*tfSmithyDocument]()),
+				traceMatchedFields("Field1", reflect.TypeFor[awsJSONStringer](), "Field1", reflect.TypeFor[*tfSmithyDocument]()),
+				infoConvertingWithPath("Field1", reflect.TypeFor[smithyjson.JSONStringer](), "Field1", reflect.TypeFor[fwtypes.SmithyDocument[smithyjson.JSONStringer]]()),
+				infoSourceImplementsJSONStringer("Field1", reflect.TypeFor[smithyjson.JSONStringer](), "Field1", reflect.TypeFor[fwtypes.SmithyDocument[smithyjson.JSONStringer]]()), // TODO: fix source type
+				traceFlatteningNullValue("Field1", reflect.TypeFor[smithyjson.JSONStringer](), "Field1", reflect.TypeFor[fwtypes.SmithyDocument[smithyjson.JSONStringer]]()),
+			},
+		},
+
 		"json interface Source marshal error": {
 			Source: &awsJSONStringer{
 				Field1: &testJSONDocumentError{},
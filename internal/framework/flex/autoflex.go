@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	pluralize "github.com/gertd/go-pluralize"
@@ -102,6 +103,11 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 
 	// TODO: this only applies when Expanding
 	if valTo.Kind() == reflect.Interface {
+		if union, ok := lookupUnionType(valTo.Type()); ok {
+			diags.Append(expandUnion(ctx, sourcePath, valFrom, targetPath, valTo, union, flexer)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "AutoFlex Expand; incompatible types", map[string]any{
 			"from": valFrom.Type(),
 			"to":   valTo.Kind(),
@@ -116,10 +122,17 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 		return diags
 	}
 
+	// TODO: this only applies when Flattening
+	if _, variant, ok := lookupUnionVariant(valFrom.Type()); ok {
+		diags.Append(flattenUnion(ctx, sourcePath, valFrom, targetPath, valTo, variant, flexer)...)
+		return diags
+	}
+
 	typeFrom := valFrom.Type()
 	typeTo := valTo.Type()
 
 	opts := flexer.getOptions()
+	var matchedTargetFields []string
 	for i := 0; i < typeFrom.NumField(); i++ {
 		fromField := typeFrom.Field(i)
 		if fromField.PkgPath != "" {
@@ -147,15 +160,21 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 			continue
 		}
 
-		toField, ok := findFieldFuzzy(ctx, fieldName, typeFrom, typeTo, flexer)
+		toField, ok := findField(ctx, fromField, typeFrom, typeTo, flexer)
 		if !ok {
 			// Corresponding field not found in to.
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fieldName,
 			})
+			if opts.reportUnmatchedFields {
+				tflog.SubsystemWarn(ctx, subsystemName, "Unmatched source field", map[string]any{
+					logAttrKeySourceFieldname: fieldName,
+				})
+			}
 			continue
 		}
 		toFieldName := toField.Name
+		matchedTargetFields = append(matchedTargetFields, toFieldName)
 		// TODO: this only applies when Flattening
 		toNameOverride, toOpts := autoflexTags(toField)
 		toFieldVal := valTo.FieldByIndex(toField.Index)
@@ -189,7 +208,11 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 
 		opts := fieldOpts{
 			legacy:    fromOpts.Legacy() || toOpts.Legacy(),
-			omitempty: toOpts.OmitEmpty(),
+			omitempty: fromOpts.OmitEmpty() || toOpts.OmitEmpty(),
+			converter: fromOpts.Converter(),
+		}
+		if v := toOpts.Converter(); v != "" {
+			opts.converter = v
 		}
 
 		diags.Append(flexer.convert(ctx, sourcePath.AtName(fieldName), valFrom.Field(i), targetPath.AtName(toFieldName), toFieldVal, opts)...)
@@ -198,9 +221,61 @@ func autoFlexConvertStruct(ctx context.Context, sourcePath path.Path, from any,
 		}
 	}
 
+	if opts.reportUnmatchedFields && !diags.HasError() {
+		for i := 0; i < typeTo.NumField(); i++ {
+			toField := typeTo.Field(i)
+			if toField.PkgPath != "" {
+				continue // Skip unexported fields.
+			}
+			toFieldName := toField.Name
+			if opts.isIgnoredField(toFieldName) || toFieldName == mapBlockKeyFieldName || slices.Contains(matchedTargetFields, toFieldName) {
+				continue
+			}
+			if toNameOverride, toOpts := autoflexTags(toField); toNameOverride == "-" || toOpts.NoFlatten() {
+				continue
+			}
+
+			tflog.SubsystemWarn(ctx, subsystemName, "Unmatched target field", map[string]any{
+				logAttrKeyTargetFieldname: toFieldName,
+			})
+		}
+	}
+
 	return diags
 }
 
+// findField returns the field of `typeTo` corresponding to `fromField`.
+// A name override in either field's "autoflex" tag takes precedence over fuzzy matching.
+func findField(ctx context.Context, fromField reflect.StructField, typeFrom, typeTo reflect.Type, flexer autoFlexer) (reflect.StructField, bool) {
+	fieldNameFrom := fromField.Name
+
+	if nameOverride, _ := autoflexTags(fromField); nameOverride != "" {
+		return typeTo.FieldByName(nameOverride)
+	}
+
+	for i := 0; i < typeTo.NumField(); i++ {
+		field := typeTo.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		if nameOverride, _ := autoflexTags(field); nameOverride == fieldNameFrom {
+			return field, true
+		}
+	}
+
+	fieldTo, ok := findFieldFuzzy(ctx, fieldNameFrom, typeFrom, typeTo, flexer)
+	if !ok {
+		return reflect.StructField{}, false
+	}
+
+	// A target field with a name override only matches the overriding name.
+	if nameOverride, _ := autoflexTags(fieldTo); nameOverride != "" && nameOverride != "-" {
+		return reflect.StructField{}, false
+	}
+
+	return fieldTo, true
+}
+
 func findFieldFuzzy(ctx context.Context, fieldNameFrom string, typeFrom reflect.Type, typeTo reflect.Type, flexer autoFlexer) (reflect.StructField, bool) {
 	// first precedence is exact match (case sensitive)
 	if fieldTo, ok := typeTo.FieldByName(fieldNameFrom); ok {
@@ -280,13 +355,17 @@ func fieldExistsInStruct(field string, structType reflect.Type) bool {
 	return ok
 }
 
+// autoflexTags returns the name override and options from a struct field's "autoflex" tag.
+// The name override may be written as `autoflex:"name=PrivateIpAddress"` or `autoflex:"PrivateIpAddress"`.
 func autoflexTags(field reflect.StructField) (string, tagOptions) {
-	return parseTag(field.Tag.Get("autoflex"))
+	name, opts := parseTag(field.Tag.Get("autoflex"))
+	return strings.TrimPrefix(name, "name="), opts
 }
 
 type fieldOpts struct {
 	legacy    bool
 	omitempty bool
+	converter string
 }
 
 // valueWithElementsAs extends the Value interface for values that have an ElementsAs method.
@@ -8,10 +8,12 @@ import (
 	"encoding/json"
 	"errors"
 	"reflect"
+	"strings"
 	"time"
 
 	smithydocument "github.com/aws/smithy-go/document"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -484,6 +486,10 @@ type tfJSONStringer struct {
 	Field1 fwtypes.SmithyJSON[smithyjson.JSONStringer] `tfsdk:"field1"`
 }
 
+type tfSmithyDocument struct {
+	Field1 fwtypes.SmithyDocument[smithyjson.JSONStringer] `tfsdk:"field1"`
+}
+
 type tfListNestedObject[T any] struct {
 	Field1 fwtypes.ListNestedObjectValueOf[T] `tfsdk:"field1"`
 }
@@ -702,3 +708,106 @@ type tfSetOfStringEnum struct {
 type awsSliceOfStringEnum struct {
 	Field1 []testEnum
 }
+
+type tfNameOverride struct {
+	IPv4Address types.String `tfsdk:"ipv4_address" autoflex:"name=PrivateIpAddress"`
+}
+
+type awsNameOverride struct {
+	Ipv4Address      *string
+	PrivateIpAddress *string
+}
+
+type tfFieldConverter struct {
+	Field1 types.String `tfsdk:"field1" autoflex:",converter=testCSV"`
+}
+
+type tfFieldConverterNotRegistered struct {
+	Field1 types.String `tfsdk:"field1" autoflex:",converter=testNotRegistered"`
+}
+
+type awsFieldConverter struct {
+	Field1 []string
+}
+
+type tfReportUnmatchedFields struct {
+	Field1 types.String `tfsdk:"field1"`
+	Field2 types.String `tfsdk:"field2"`
+}
+
+type awsReportUnmatchedFields struct {
+	Field1 *string
+	Field3 *string
+}
+
+func init() {
+	RegisterFieldConverter("testCSV", testCSVConverter{})
+}
+
+// testCSVConverter converts between a comma-separated string and a slice of strings.
+type testCSVConverter struct{}
+
+func (testCSVConverter) Expand(ctx context.Context, from attr.Value, targetType reflect.Type) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, ok := from.(types.String)
+	if !ok || targetType != reflect.TypeFor[[]string]() {
+		diags.AddError("testCSVConverter", "unexpected types")
+		return nil, diags
+	}
+
+	return strings.Split(v.ValueString(), ","), diags
+}
+
+func (testCSVConverter) Flatten(ctx context.Context, from any, targetType reflect.Type) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, ok := from.([]string)
+	if !ok || targetType != reflect.TypeFor[types.String]() {
+		diags.AddError("testCSVConverter", "unexpected types")
+		return nil, diags
+	}
+
+	if v == nil {
+		return types.StringNull(), diags
+	}
+
+	return types.StringValue(strings.Join(v, ",")), diags
+}
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberString struct {
+	Value string
+}
+
+func (*awsUnionMemberString) isAWSUnion() {}
+
+type awsUnionMemberNested struct {
+	Value awsSingleStringValue
+}
+
+func (*awsUnionMemberNested) isAWSUnion() {}
+
+type awsUnionField struct {
+	Field1 awsUnion
+}
+
+type tfUnion struct {
+	String types.String                                         `tfsdk:"string"`
+	Nested fwtypes.ListNestedObjectValueOf[tfSingleStringField] `tfsdk:"nested"`
+}
+
+type tfUnionField struct {
+	Field1 fwtypes.ListNestedObjectValueOf[tfUnion] `tfsdk:"field1"`
+}
+
+func init() {
+	RegisterUnionType[awsUnion](&awsUnionMemberString{}, &awsUnionMemberNested{})
+}
+
+func testUnionPtr(v awsUnion) *awsUnion {
+	return &v
+}
@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// FieldConverter is implemented by custom conversions between a Plugin Framework value and its AWS API equivalent.
+// A converter is registered by name with RegisterFieldConverter and selected for a field by
+// the field's `autoflex:",converter=<name>"` struct tag.
+type FieldConverter interface {
+	// Expand converts a non-null, known Plugin Framework value to a value assignable to targetType.
+	Expand(ctx context.Context, from attr.Value, targetType reflect.Type) (any, diag.Diagnostics)
+	// Flatten converts an AWS API value to a Plugin Framework value assignable to targetType.
+	Flatten(ctx context.Context, from any, targetType reflect.Type) (attr.Value, diag.Diagnostics)
+}
+
+var fieldConverters = struct {
+	sync.RWMutex
+	converters map[string]FieldConverter
+}{
+	converters: make(map[string]FieldConverter),
+}
+
+// RegisterFieldConverter registers a named field converter.
+// It is intended to be called from package init functions and panics if the name is already registered.
+func RegisterFieldConverter(name string, converter FieldConverter) {
+	fieldConverters.Lock()
+	defer fieldConverters.Unlock()
+
+	if _, ok := fieldConverters.converters[name]; ok {
+		panic(fmt.Sprintf("AutoFlex field converter %q already registered", name))
+	}
+
+	fieldConverters.converters[name] = converter
+}
+
+func fieldConverter(name string) (FieldConverter, bool) {
+	fieldConverters.RLock()
+	defer fieldConverters.RUnlock()
+
+	converter, ok := fieldConverters.converters[name]
+
+	return converter, ok
+}
+
+func expandFieldConverter(ctx context.Context, name string, vFrom attr.Value, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	converter, ok := fieldConverter(name)
+	if !ok {
+		tflog.SubsystemError(ctx, subsystemName, "Field converter not registered", map[string]any{
+			logAttrKeyConverter: name,
+		})
+		diags.Append(diagFieldConverterNotRegistered(name))
+		return diags
+	}
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Using field converter", map[string]any{
+		logAttrKeyConverter: name,
+	})
+
+	if vFrom.IsNull() || vFrom.IsUnknown() {
+		return diags
+	}
+
+	expanded, d := converter.Expand(ctx, vFrom, vTo.Type())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if expanded == nil {
+		return diags
+	}
+
+	expandedType := reflect.TypeOf(expanded)
+	if !expandedType.AssignableTo(vTo.Type()) {
+		diags.Append(diagCannotBeAssigned(expandedType, vTo.Type()))
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(expanded))
+
+	return diags
+}
+
+func flattenFieldConverter(ctx context.Context, name string, vFrom reflect.Value, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	converter, ok := fieldConverter(name)
+	if !ok {
+		tflog.SubsystemError(ctx, subsystemName, "Field converter not registered", map[string]any{
+			logAttrKeyConverter: name,
+		})
+		diags.Append(diagFieldConverterNotRegistered(name))
+		return diags
+	}
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Using field converter", map[string]any{
+		logAttrKeyConverter: name,
+	})
+
+	var from any
+	if vFrom.IsValid() {
+		from = vFrom.Interface()
+	}
+
+	flattened, d := converter.Flatten(ctx, from, vTo.Type())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if flattened == nil {
+		diags.Append(diagFlattensToNil(name))
+		return diags
+	}
+
+	flattenedType := reflect.TypeOf(flattened)
+	if !flattenedType.AssignableTo(vTo.Type()) {
+		diags.Append(diagFlatteningIncompatibleTypes(flattenedType, vTo.Type()))
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(flattened))
+
+	return diags
+}
+
+func diagFieldConverterNotRegistered(name string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while converting configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Field converter %q is not registered.", name),
+	)
+}
+
+func diagFlattensToNil(name string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while flattening configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Field converter %q returned nil.", name),
+	)
+}
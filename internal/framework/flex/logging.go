@@ -24,6 +24,9 @@ const (
 	logAttrKeyTargetFieldname = "autoflex.target.fieldname"
 	logAttrKeyTargetPath      = "autoflex.target.path"
 
+	logAttrKeyConverter   = "autoflex.converter"
+	logAttrKeyUnionMember = "autoflex.union.member"
+
 	logAttrKeyError = "error"
 )
 
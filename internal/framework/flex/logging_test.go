@@ -269,6 +269,121 @@ func debugNoCorrespondingField(sourceType reflect.Type, sourceFieldName string,
 	}
 }
 
+func warnUnmatchedSourceField(sourceType reflect.Type, sourceFieldName string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":                  hclog.Warn.String(),
+		"@module":                 logModule,
+		"@message":                "Unmatched source field",
+		logAttrKeySourcePath:      "",
+		logAttrKeySourceType:      fullTypeName(sourceType),
+		logAttrKeySourceFieldname: sourceFieldName,
+		logAttrKeyTargetPath:      "",
+		logAttrKeyTargetType:      fullTypeName(targetType),
+	}
+}
+
+func warnUnmatchedTargetField(sourceType reflect.Type, targetType reflect.Type, targetFieldName string) map[string]any {
+	return map[string]any{
+		"@level":                  hclog.Warn.String(),
+		"@module":                 logModule,
+		"@message":                "Unmatched target field",
+		logAttrKeySourcePath:      "",
+		logAttrKeySourceType:      fullTypeName(sourceType),
+		logAttrKeyTargetPath:      "",
+		logAttrKeyTargetType:      fullTypeName(targetType),
+		logAttrKeyTargetFieldname: targetFieldName,
+	}
+}
+
+func infoUsingFieldConverter(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type, converter string) map[string]any {
+	return logInfo("Using field converter", map[string]any{
+		logAttrKeySourcePath: sourcePath,
+		logAttrKeySourceType: fullTypeName(sourceType),
+		logAttrKeyTargetPath: targetPath,
+		logAttrKeyTargetType: fullTypeName(targetType),
+		logAttrKeyConverter:  converter,
+	})
+}
+
+func errorFieldConverterNotRegistered(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type, converter string) map[string]any {
+	return map[string]any{
+		"@level":             hclog.Error.String(),
+		"@module":            logModule,
+		"@message":           "Field converter not registered",
+		logAttrKeySourcePath: sourcePath,
+		logAttrKeySourceType: fullTypeName(sourceType),
+		logAttrKeyTargetPath: targetPath,
+		logAttrKeyTargetType: fullTypeName(targetType),
+		logAttrKeyConverter:  converter,
+	}
+}
+
+func infoExpandingUnion(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return infoWithPathLogLine("Expanding union", sourcePath, sourceType, targetPath, targetType)
+}
+
+func infoFlatteningUnion(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type, member string) map[string]any {
+	return logInfo("Flattening union", map[string]any{
+		logAttrKeySourcePath:  sourcePath,
+		logAttrKeySourceType:  fullTypeName(sourceType),
+		logAttrKeyTargetPath:  targetPath,
+		logAttrKeyTargetType:  fullTypeName(targetType),
+		logAttrKeyUnionMember: member,
+	})
+}
+
+func traceExpandingMatchedUnionMember(sourcePath string, sourceType reflect.Type, sourceFieldName string, targetPath string, targetType reflect.Type, member string) map[string]any {
+	return map[string]any{
+		"@level":                  hclog.Trace.String(),
+		"@module":                 logModule,
+		"@message":                "Matched union member",
+		logAttrKeySourcePath:      sourcePath,
+		logAttrKeySourceType:      fullTypeName(sourceType),
+		logAttrKeySourceFieldname: sourceFieldName,
+		logAttrKeyTargetPath:      targetPath,
+		logAttrKeyTargetType:      fullTypeName(targetType),
+		logAttrKeyUnionMember:     member,
+	}
+}
+
+func traceFlatteningMatchedUnionMember(sourcePath string, sourceType reflect.Type, member string, targetPath string, targetType reflect.Type, targetFieldName string) map[string]any {
+	return map[string]any{
+		"@level":                  hclog.Trace.String(),
+		"@module":                 logModule,
+		"@message":                "Matched union member",
+		logAttrKeySourcePath:      sourcePath,
+		logAttrKeySourceType:      fullTypeName(sourceType),
+		logAttrKeyUnionMember:     member,
+		logAttrKeyTargetPath:      targetPath,
+		logAttrKeyTargetType:      fullTypeName(targetType),
+		logAttrKeyTargetFieldname: targetFieldName,
+	}
+}
+
+func traceNoUnionMemberSet(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":             hclog.Trace.String(),
+		"@module":            logModule,
+		"@message":           "No union member set",
+		logAttrKeySourcePath: sourcePath,
+		logAttrKeySourceType: fullTypeName(sourceType),
+		logAttrKeyTargetPath: targetPath,
+		logAttrKeyTargetType: fullTypeName(targetType),
+	}
+}
+
+func errorMultipleUnionMembersSet(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
+	return map[string]any{
+		"@level":             hclog.Error.String(),
+		"@module":            logModule,
+		"@message":           "Multiple union members set",
+		logAttrKeySourcePath: sourcePath,
+		logAttrKeySourceType: fullTypeName(sourceType),
+		logAttrKeyTargetPath: targetPath,
+		logAttrKeyTargetType: fullTypeName(targetType),
+	}
+}
+
 func traceExpandingNullValue(sourcePath string, sourceType reflect.Type, targetPath string, targetType reflect.Type) map[string]any {
 	return map[string]any{
 		"@level":             hclog.Trace.String(),
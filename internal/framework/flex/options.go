@@ -25,6 +25,10 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// reportUnmatchedFields logs source and target fields which have no
+	// corresponding field
+	reportUnmatchedFields bool
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -75,6 +79,17 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithReportUnmatchedFields logs a warning for each source field with no
+// corresponding target field and for each target field which no source
+// field was matched to
+//
+// Use this option in tests to make gaps in AutoFlex field matching visible.
+func WithReportUnmatchedFields() AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.reportUnmatchedFields = true
+	}
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	for _, name := range o.ignoredFieldNames {
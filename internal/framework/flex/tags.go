@@ -38,6 +38,19 @@ func (o tagOptions) Contains(optionName string) bool {
 	return false
 }
 
+// Value returns the value of a particular key=value option, or the empty string.
+func (o tagOptions) Value(optionName string) string {
+	s := string(o)
+	for s != "" {
+		var name string
+		name, s, _ = strings.Cut(s, ",")
+		if k, v, ok := strings.Cut(name, "="); ok && k == optionName {
+			return v
+		}
+	}
+	return ""
+}
+
 func (o tagOptions) Legacy() bool {
 	return o.Contains("legacy")
 }
@@ -49,3 +62,7 @@ func (o tagOptions) OmitEmpty() bool {
 func (o tagOptions) NoFlatten() bool {
 	return o.Contains("noflatten")
 }
+
+func (o tagOptions) Converter() string {
+	return o.Value("converter")
+}
@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	unionMemberValueFieldName = "Value"
+)
+
+// unionType describes an AWS API union, an interface implemented by one variant struct type per union member.
+type unionType struct {
+	typ      reflect.Type
+	variants []unionVariant
+}
+
+// unionVariant describes one member of an AWS API union.
+type unionVariant struct {
+	// typ is the variant struct type, e.g. awstypes.StorageConfigurationMemberEfs.
+	typ reflect.Type
+	// memberName is the union member name, e.g. "Efs".
+	memberName string
+}
+
+var unionTypes = struct {
+	sync.RWMutex
+	byInterface map[reflect.Type]*unionType
+	byVariant   map[reflect.Type]*unionType
+}{
+	byInterface: make(map[reflect.Type]*unionType),
+	byVariant:   make(map[reflect.Type]*unionType),
+}
+
+// RegisterUnionType registers the variant types of the AWS API union interface T.
+// Each variant is passed as a pointer to its struct type, which must implement T and have a single field named `Value`, e.g.
+//
+//	flex.RegisterUnionType[awstypes.StorageConfiguration](&awstypes.StorageConfigurationMemberEfs{}, &awstypes.StorageConfigurationMemberFsx{})
+//
+// When expanding, the one set field of a model struct is expanded into the `Value` field of the corresponding variant.
+// When flattening, the `Value` field of a variant is flattened into the corresponding field of a model struct and the model's other fields are set to null.
+// A model field corresponds to the variant whose union member name (e.g. "Efs" for StorageConfigurationMemberEfs) matches the field's name,
+// ignoring case, or the name set in the field's `autoflex` struct tag.
+//
+// It is intended to be called from package init functions and panics if the union is already registered or a variant is invalid.
+func RegisterUnionType[T any](variants ...T) {
+	typ := reflect.TypeFor[T]()
+	if typ.Kind() != reflect.Interface {
+		panic(fmt.Sprintf("AutoFlex union type %q is not an interface", fullTypeName(typ)))
+	}
+
+	union := &unionType{
+		typ: typ,
+	}
+	for _, v := range variants {
+		t := reflect.TypeOf(v)
+		if t == nil || t.Kind() != reflect.Pointer || t.Elem().Kind() != reflect.Struct {
+			panic(fmt.Sprintf("AutoFlex union type %q variant %q is not a pointer to struct", fullTypeName(typ), fullTypeName(t)))
+		}
+		t = t.Elem()
+		if _, ok := t.FieldByName(unionMemberValueFieldName); !ok {
+			panic(fmt.Sprintf("AutoFlex union type %q variant %q has no %s field", fullTypeName(typ), fullTypeName(t), unionMemberValueFieldName))
+		}
+
+		union.variants = append(union.variants, unionVariant{
+			typ:        t,
+			memberName: unionMemberName(typ, t),
+		})
+	}
+
+	unionTypes.Lock()
+	defer unionTypes.Unlock()
+
+	if _, ok := unionTypes.byInterface[typ]; ok {
+		panic(fmt.Sprintf("AutoFlex union type %q already registered", fullTypeName(typ)))
+	}
+
+	unionTypes.byInterface[typ] = union
+	for _, v := range union.variants {
+		unionTypes.byVariant[v.typ] = union
+	}
+}
+
+// unionMemberName returns the union member name of a variant type, e.g. "Efs" for StorageConfigurationMemberEfs.
+func unionMemberName(union, variant reflect.Type) string {
+	name := variant.Name()
+	if v, ok := strings.CutPrefix(name, union.Name()+"Member"); ok {
+		return v
+	}
+	if i := strings.LastIndex(name, "Member"); i >= 0 {
+		return name[i+len("Member"):]
+	}
+	return name
+}
+
+// lookupUnionType returns the registered union with the specified interface type.
+func lookupUnionType(typ reflect.Type) (*unionType, bool) {
+	unionTypes.RLock()
+	defer unionTypes.RUnlock()
+
+	union, ok := unionTypes.byInterface[typ]
+
+	return union, ok
+}
+
+// lookupUnionVariant returns the registered union and variant for the specified variant struct type.
+func lookupUnionVariant(typ reflect.Type) (*unionType, unionVariant, bool) {
+	unionTypes.RLock()
+	defer unionTypes.RUnlock()
+
+	union, ok := unionTypes.byVariant[typ]
+	if !ok {
+		return nil, unionVariant{}, false
+	}
+
+	for _, v := range union.variants {
+		if v.typ == typ {
+			return union, v, true
+		}
+	}
+
+	return nil, unionVariant{}, false
+}
+
+// modelField returns the field of the model struct type corresponding to the union member.
+func (v unionVariant) modelField(typ reflect.Type, opts AutoFlexOptions) (reflect.StructField, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue // Skip unexported fields.
+		}
+		if opts.isIgnoredField(field.Name) {
+			continue
+		}
+
+		nameOverride, _ := autoflexTags(field)
+		if nameOverride == "-" {
+			continue
+		}
+		if nameOverride == v.memberName || (nameOverride == "" && strings.EqualFold(field.Name, v.memberName)) {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// expandUnion expands the one set field of model struct `valFrom` into a new variant of the union interface `valTo`.
+func expandUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, union *unionType, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Expanding union")
+
+	opts := flexer.getOptions()
+	var (
+		set     string
+		variant reflect.Value
+	)
+	for _, v := range union.variants {
+		field, ok := v.modelField(valFrom.Type(), opts)
+		if !ok {
+			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding union member field", map[string]any{
+				logAttrKeyUnionMember: v.memberName,
+			})
+			continue
+		}
+
+		fieldVal := valFrom.FieldByIndex(field.Index)
+		if !isSetUnionMemberValue(fieldVal) {
+			continue
+		}
+
+		if set != "" {
+			tflog.SubsystemError(ctx, subsystemName, "Multiple union members set")
+			diags.Append(diagExpandingMultipleUnionMembers(valFrom.Type(), set, field.Name))
+			return diags
+		}
+		set = field.Name
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceFieldname: field.Name,
+			logAttrKeyUnionMember:     v.memberName,
+		})
+
+		variant = reflect.New(v.typ)
+		diags.Append(flexer.convert(ctx, sourcePath.AtName(field.Name), fieldVal, targetPath.AtName(v.memberName), variant.Elem().FieldByName(unionMemberValueFieldName), fieldOpts{})...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if set == "" {
+		tflog.SubsystemTrace(ctx, subsystemName, "No union member set")
+		return diags
+	}
+
+	valTo.Set(variant)
+
+	return diags
+}
+
+// isSetUnionMemberValue returns whether a model field value sets a union member.
+// Null, unknown and empty collection values do not set a member.
+func isSetUnionMemberValue(v reflect.Value) bool {
+	value, ok := v.Interface().(attr.Value)
+	if !ok {
+		return false
+	}
+
+	if value.IsNull() || value.IsUnknown() {
+		return false
+	}
+
+	if value, ok := value.(valueWithElementsAs); ok {
+		return len(value.Elements()) > 0
+	}
+
+	return true
+}
+
+// flattenUnion flattens the `Value` field of union variant struct `valFrom` into the corresponding field of model struct `valTo`.
+func flattenUnion(ctx context.Context, sourcePath path.Path, valFrom reflect.Value, targetPath path.Path, valTo reflect.Value, variant unionVariant, flexer autoFlexer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.SubsystemInfo(ctx, subsystemName, "Flattening union", map[string]any{
+		logAttrKeyUnionMember: variant.memberName,
+	})
+
+	// Fields for the other union members are null.
+	diags.Append(flattenPrePopulate(ctx, valTo)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	field, ok := variant.modelField(valTo.Type(), flexer.getOptions())
+	if !ok {
+		tflog.SubsystemDebug(ctx, subsystemName, "No corresponding union member field", map[string]any{
+			logAttrKeyUnionMember: variant.memberName,
+		})
+		return diags
+	}
+
+	tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+		logAttrKeyUnionMember:     variant.memberName,
+		logAttrKeyTargetFieldname: field.Name,
+	})
+
+	diags.Append(flexer.convert(ctx, sourcePath.AtName(unionMemberValueFieldName), valFrom.FieldByName(unionMemberValueFieldName), targetPath.AtName(field.Name), valTo.FieldByIndex(field.Index), fieldOpts{})...)
+
+	return diags
+}
+
+func diagExpandingMultipleUnionMembers(sourceType reflect.Type, fieldName1, fieldName2 string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Type %q sets multiple union members: %q and %q.", fullTypeName(sourceType), fieldName1, fieldName2),
+	)
+}
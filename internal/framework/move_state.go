@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	sdkv2StateMoverProviderAddressSuffix = "hashicorp/aws"
+)
+
+// SDKv2StateTransformFunc modifies a Plugin SDKv2 resource's upgraded state, in its JSON map representation,
+// before it is decoded into the target resource's schema.
+type SDKv2StateTransformFunc func(ctx context.Context, state map[string]any) (map[string]any, error)
+
+type sdkv2StateMoverOptions struct {
+	meta      any
+	transform SDKv2StateTransformFunc
+}
+
+// SDKv2StateMoverOptionsFunc configures a state mover returned by StateMoverFromSDKv2Resource.
+type SDKv2StateMoverOptionsFunc func(*sdkv2StateMoverOptions)
+
+// WithSDKv2StateMoverMeta sets the provider meta passed to the source resource's state upgraders.
+func WithSDKv2StateMoverMeta(meta any) SDKv2StateMoverOptionsFunc {
+	return func(o *sdkv2StateMoverOptions) {
+		o.meta = meta
+	}
+}
+
+// WithSDKv2StateMoverTransform sets a function that maps the source resource's upgraded state to the target resource's attributes,
+// e.g. to rename or restructure attributes.
+func WithSDKv2StateMoverTransform(f SDKv2StateTransformFunc) SDKv2StateMoverOptionsFunc {
+	return func(o *sdkv2StateMoverOptions) {
+		o.transform = f
+	}
+}
+
+// StateMoverFromSDKv2Resource returns a state mover that moves the state of the Plugin SDKv2 resource `sourceTypeName`,
+// implemented by `source`, to a Plugin Framework resource.
+// It allows a resource that is reimplemented using the Plugin Framework under a new type name to support `moved` blocks from the old type:
+//
+//	func (r *instanceResource) MoveState(ctx context.Context) []resource.StateMover {
+//		return []resource.StateMover{
+//			framework.StateMoverFromSDKv2Resource("aws_instance", resourceInstance(), framework.WithSDKv2StateMoverMeta(r.Meta())),
+//		}
+//	}
+//
+// The source state is upgraded to the source resource's current schema version by its state upgraders
+// and normalized to the source resource's schema, as Plugin SDKv2 does for JSON states,
+// optionally transformed and then decoded into the target resource's schema.
+// Source attributes not in the target schema are ignored and target attributes not in the source state are null.
+// States with a schema version that the source resource's legacy MigrateState function handles can't be moved,
+// as Plugin SDKv2 only migrates flatmap states.
+// State is only moved from resources of type `sourceTypeName` in this provider.
+func StateMoverFromSDKv2Resource(sourceTypeName string, source *schema.Resource, optFns ...SDKv2StateMoverOptionsFunc) resource.StateMover {
+	opts := sdkv2StateMoverOptions{}
+	for _, optFn := range optFns {
+		optFn(&opts)
+	}
+
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, request resource.MoveStateRequest, response *resource.MoveStateResponse) {
+			if request.SourceTypeName != sourceTypeName {
+				return
+			}
+
+			if !strings.HasSuffix(request.SourceProviderAddress, sdkv2StateMoverProviderAddressSuffix) {
+				return
+			}
+
+			ctx = tflog.SetField(ctx, "tf_source_resource_type", sourceTypeName)
+
+			if request.SourceRawState == nil || len(request.SourceRawState.JSON) == 0 {
+				response.Diagnostics.AddError("Unable to Move Resource State", "The source resource state is not available in JSON format.")
+				return
+			}
+
+			if version := request.SourceSchemaVersion; version > int64(source.SchemaVersion) {
+				response.Diagnostics.AddError(
+					"Unable to Move Resource State",
+					fmt.Sprintf("The source resource state has schema version %d, newer than the supported version %d.", version, source.SchemaVersion),
+				)
+				return
+			}
+
+			state, err := upgradeSDKv2JSONState(ctx, source, int(request.SourceSchemaVersion), request.SourceRawState.JSON, opts.meta)
+			if err != nil {
+				response.Diagnostics.AddError("Upgrading Source Resource State", err.Error())
+				return
+			}
+
+			state, err = normalizeSDKv2JSONState(ctx, source, state)
+			if err != nil {
+				response.Diagnostics.AddError("Normalizing Source Resource State", err.Error())
+				return
+			}
+
+			if opts.transform != nil {
+				state, err = opts.transform(ctx, state)
+				if err != nil {
+					response.Diagnostics.AddError("Transforming Source Resource State", err.Error())
+					return
+				}
+			}
+
+			b, err := json.Marshal(state)
+			if err != nil {
+				response.Diagnostics.AddError("Encoding Source Resource State", err.Error())
+				return
+			}
+
+			rawState := tfprotov6.RawState{JSON: b}
+			targetType := response.TargetState.Schema.Type().TerraformType(ctx)
+			raw, err := rawState.UnmarshalWithOpts(targetType, tfprotov6.UnmarshalOpts{
+				ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+					IgnoreUndefinedAttributes: true,
+				},
+			})
+			if err != nil {
+				response.Diagnostics.AddError("Decoding Source Resource State", err.Error())
+				return
+			}
+
+			response.TargetState.Raw = raw
+		},
+	}
+}
+
+// upgradeSDKv2JSONState decodes a Plugin SDKv2 resource's JSON state and applies the resource's state upgraders,
+// starting at the specified schema version.
+// See the Plugin SDKv2 GRPCProviderServer's UpgradeResourceState and upgradeJSONState.
+func upgradeSDKv2JSONState(ctx context.Context, source *schema.Resource, version int, b []byte, meta any) (map[string]any, error) {
+	var state map[string]any
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	if source.UseJSONNumber {
+		dec.UseNumber()
+	}
+	if err := dec.Decode(&state); err != nil {
+		return nil, err
+	}
+
+	// See the Plugin SDKv2 GRPCProviderServer's upgradeFlatmapState.
+	requiresMigrate := version < source.SchemaVersion
+	if len(source.StateUpgraders) > 0 {
+		requiresMigrate = version < source.StateUpgraders[0].Version
+	}
+	if requiresMigrate && source.MigrateState != nil {
+		return nil, fmt.Errorf("schema version %d state can only be migrated by MigrateState, which is not supported; refresh the source resource with a current provider version before moving it", version)
+	}
+
+	for _, upgrader := range source.StateUpgraders {
+		if version != upgrader.Version {
+			continue
+		}
+
+		tflog.Debug(ctx, "Upgrading source resource state", map[string]any{
+			"tf_source_schema_version": version,
+		})
+
+		var err error
+		state, err = upgrader.Upgrade(ctx, state, meta)
+		if err != nil {
+			return nil, fmt.Errorf("upgrading from schema version %d: %w", version, err)
+		}
+		version++
+	}
+
+	return state, nil
+}
+
+// normalizeSDKv2JSONState removes attributes that are not in the source resource's schema from its upgraded state
+// and converts the remaining attribute values to their schema types.
+// See the Plugin SDKv2 GRPCProviderServer's UpgradeResourceState.
+func normalizeSDKv2JSONState(ctx context.Context, source *schema.Resource, state map[string]any) (map[string]any, error) {
+	block := source.CoreConfigSchema()
+	ty := block.ImpliedType()
+
+	removeSDKv2Attributes(ctx, state, ty)
+
+	val, err := schema.JSONMapToStateValue(state, block)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := ctyjson.Marshal(val, ty)
+	if err != nil {
+		return nil, err
+	}
+
+	state = nil
+	dec := json.NewDecoder(bytes.NewReader(b))
+	if source.UseJSONNumber {
+		dec.UseNumber()
+	}
+	if err := dec.Decode(&state); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// removeSDKv2Attributes removes attributes that are not in the specified type from a JSON state value.
+// See the Plugin SDKv2 GRPCProviderServer's removeAttributes.
+func removeSDKv2Attributes(ctx context.Context, v any, ty cty.Type) {
+	switch v := v.(type) {
+	case []any:
+		if ty.IsListType() || ty.IsSetType() {
+			for _, v := range v {
+				removeSDKv2Attributes(ctx, v, ty.ElementType())
+			}
+		}
+	case map[string]any:
+		if ty.IsMapType() {
+			for _, v := range v {
+				removeSDKv2Attributes(ctx, v, ty.ElementType())
+			}
+			return
+		}
+
+		if !ty.IsObjectType() {
+			return
+		}
+
+		attrTypes := ty.AttributeTypes()
+		for k, attrV := range v {
+			attrType, ok := attrTypes[k]
+			if !ok {
+				tflog.Debug(ctx, "Removing source resource attribute not in schema", map[string]any{
+					"tf_attribute_path": k,
+				})
+				delete(v, k)
+				continue
+			}
+
+			removeSDKv2Attributes(ctx, attrV, attrType)
+		}
+	}
+}
@@ -37,6 +37,10 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 
 	defaultTagsConfig := r.Meta().DefaultTagsConfig(ctx)
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig(ctx)
+	// The resource's opt out of provider default tags, if any, is reflected in Context.
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		defaultTagsConfig = inContext.DefaultConfig
+	}
 
 	var planTags tftags.Map
 
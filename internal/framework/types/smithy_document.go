@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+var (
+	_ basetypes.StringTypable = (*SmithyDocumentType[smithyjson.JSONStringer])(nil)
+)
+
+// SmithyDocumentType is the attribute type of a freeform JSON value that maps to an AWS API
+// [Smithy document](https://smithy.io/2.0/spec/simple-types.html#document).
+// `f` constructs the service's document value, e.g. `document.NewLazyDocument`.
+type SmithyDocumentType[T smithyjson.JSONStringer] struct {
+	basetypes.StringType
+	f func(any) T
+}
+
+func NewSmithyDocumentType[T smithyjson.JSONStringer](_ context.Context, f func(any) T) SmithyDocumentType[T] {
+	return SmithyDocumentType[T]{
+		f: f,
+	}
+}
+
+// String returns a human readable string of the type name.
+func (t SmithyDocumentType[T]) String() string {
+	return "fwtypes.SmithyDocumentType"
+}
+
+// ValueType returns the Value type.
+func (t SmithyDocumentType[T]) ValueType(context.Context) attr.Value {
+	return SmithyDocument[T]{}
+}
+
+// Equal returns true if the given type is equivalent.
+func (t SmithyDocumentType[T]) Equal(o attr.Type) bool {
+	other, ok := o.(SmithyDocumentType[T])
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t SmithyDocumentType[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t SmithyDocumentType[T]) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return SmithyDocumentNull[T](), diags
+	}
+
+	if in.IsUnknown() {
+		return SmithyDocumentUnknown[T](), diags
+	}
+
+	return SmithyDocument[T]{StringValue: in, f: t.f}, diags
+}
+
+// SmithyDocumentValuable is implemented by values that can be converted to an AWS API Smithy document.
+type SmithyDocumentValuable interface {
+	attr.Value
+	ToSmithyDocument(context.Context) (smithyjson.JSONStringer, diag.Diagnostics)
+}
+
+var (
+	_ basetypes.StringValuable                   = (*SmithyDocument[smithyjson.JSONStringer])(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*SmithyDocument[smithyjson.JSONStringer])(nil)
+	_ xattr.ValidateableAttribute                = (*SmithyDocument[smithyjson.JSONStringer])(nil)
+	_ SmithyDocumentValuable                     = (*SmithyDocument[smithyjson.JSONStringer])(nil)
+)
+
+// SmithyDocument is a JSON string value that maps to an AWS API Smithy document.
+// Values are semantically equal if they represent the same document, regardless of object key order,
+// insignificant whitespace or the representation of numbers.
+type SmithyDocument[T smithyjson.JSONStringer] struct {
+	basetypes.StringValue
+	f func(any) T
+}
+
+func (v SmithyDocument[T]) Equal(o attr.Value) bool {
+	other, ok := o.(SmithyDocument[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v SmithyDocument[T]) Type(context.Context) attr.Type {
+	return SmithyDocumentType[T]{f: v.f}
+}
+
+// ValueSmithyDocument returns the value as an AWS API Smithy document.
+// The zero value of T is returned for null and unknown values.
+func (v SmithyDocument[T]) ValueSmithyDocument() (T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var zero T
+	if v.IsNull() || v.IsUnknown() {
+		return zero, diags
+	}
+
+	if v.f == nil {
+		diags.AddError(
+			"Smithy Document Conversion Error",
+			"An unexpected error occurred while converting a JSON string to a Smithy document. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: no document constructor set for "+fmt.Sprintf("%T", v),
+		)
+		return zero, diags
+	}
+
+	var data any
+	if err := smithyjson.DecodeFromString(v.ValueString(), &data); err != nil {
+		diags.AddError(
+			"JSON Unmarshal Error",
+			"An unexpected error occurred while unmarshalling a JSON string. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return zero, diags
+	}
+
+	return v.f(data), diags
+}
+
+func (v SmithyDocument[T]) ToSmithyDocument(context.Context) (smithyjson.JSONStringer, diag.Diagnostics) {
+	doc, diags := v.ValueSmithyDocument()
+	if diags.HasError() || v.IsNull() || v.IsUnknown() {
+		return nil, diags
+	}
+
+	return doc, diags
+}
+
+func (v SmithyDocument[T]) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Smithy Document Value",
+			"The provided value is not valid JSON string format (RFC 7159).\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Value: "+v.ValueString(),
+		)
+	}
+}
+
+func (v SmithyDocument[T]) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SmithyDocument[T])
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	// Invalid JSON is reported by validation.
+	equivalent, err := smithyjson.SmithyDocumentsEquivalent(v.ValueString(), newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return equivalent, diags
+}
+
+func SmithyDocumentValue[T smithyjson.JSONStringer](value string, f func(any) T) SmithyDocument[T] {
+	return SmithyDocument[T]{
+		StringValue: basetypes.NewStringValue(value),
+		f:           f,
+	}
+}
+
+func SmithyDocumentNull[T smithyjson.JSONStringer]() SmithyDocument[T] {
+	return SmithyDocument[T]{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+func SmithyDocumentUnknown[T smithyjson.JSONStringer]() SmithyDocument[T] {
+	return SmithyDocument[T]{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
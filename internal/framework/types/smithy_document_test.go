@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	smithyjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+func TestSmithyDocumentTypeValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val      tftypes.Value
+		expected attr.Value
+	}{
+		"null value": {
+			val:      tftypes.NewValue(tftypes.String, nil),
+			expected: fwtypes.SmithyDocumentNull[smithyjson.JSONStringer](),
+		},
+		"unknown value": {
+			val:      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			expected: fwtypes.SmithyDocumentUnknown[smithyjson.JSONStringer](),
+		},
+		"valid SmithyDocument": {
+			val:      tftypes.NewValue(tftypes.String, `{"test": "value"}`),
+			expected: fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`{"test": "value"}`, nil),
+		},
+		"invalid SmithyDocument": {
+			val:      tftypes.NewValue(tftypes.String, "not ok"),
+			expected: fwtypes.SmithyDocumentValue[smithyjson.JSONStringer]("not ok", nil),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			val, err := fwtypes.NewSmithyDocumentType(ctx, newTestJSONDocument).ValueFromTerraform(ctx, test.val)
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(val, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSmithyDocumentValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val         fwtypes.SmithyDocument[smithyjson.JSONStringer]
+		expectError bool
+	}{
+		"null value": {
+			val: fwtypes.SmithyDocumentNull[smithyjson.JSONStringer](),
+		},
+		"unknown value": {
+			val: fwtypes.SmithyDocumentUnknown[smithyjson.JSONStringer](),
+		},
+		"valid SmithyDocument": {
+			val: fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`{"test": "value"}`, nil),
+		},
+		"valid scalar SmithyDocument": {
+			val: fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`42`, nil),
+		},
+		"invalid SmithyDocument": {
+			val:         fwtypes.SmithyDocumentValue[smithyjson.JSONStringer]("not ok", nil),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := xattr.ValidateAttributeRequest{}
+			resp := xattr.ValidateAttributeResponse{}
+
+			test.val.ValidateAttribute(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
+			}
+		})
+	}
+}
+
+func TestSmithyDocumentStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.SmithyDocument[smithyjson.JSONStringer]
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"equal": {
+			val1:   fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`{"test": "value"}`, nil),
+			val2:   fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`{"test": "value"}`, nil),
+			equals: true,
+		},
+		"key order and whitespace": {
+			val1: fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`{"a": "x", "b": {"d": [1, 2], "c": true}}`, nil),
+			val2: fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`{
+  "b": {
+    "c": true,
+    "d": [1, 2]
+  },
+  "a": "x"
+}`, nil),
+			equals: true,
+		},
+		"numeric representation": {
+			val1:   fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`{"maxTokens": 2048, "temperature": 0.50, "topP": 1}`, nil),
+			val2:   fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`{"maxTokens": 2.048e3, "temperature": 0.5, "topP": 1.0}`, nil),
+			equals: true,
+		},
+		"not equal": {
+			val1: fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`{"temperature": 0.5}`, nil),
+			val2: fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`{"temperature": 0.51}`, nil),
+		},
+		"array order": {
+			val1: fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`["a", "b"]`, nil),
+			val2: fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`["b", "a"]`, nil),
+		},
+		"invalid": {
+			val1: fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`not ok`, nil),
+			val2: fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`not ok`, nil),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
+
+func TestSmithyDocumentValueSmithyDocument(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		val         fwtypes.SmithyDocument[smithyjson.JSONStringer]
+		expected    smithyjson.JSONStringer
+		expectError bool
+	}{
+		"null value": {
+			val: fwtypes.SmithyDocumentNull[smithyjson.JSONStringer](),
+		},
+		"unknown value": {
+			val: fwtypes.SmithyDocumentUnknown[smithyjson.JSONStringer](),
+		},
+		"valid SmithyDocument": {
+			val: fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`{"test": "value"}`, newTestJSONDocument),
+			expected: &testJSONDocument{
+				Value: map[string]any{
+					"test": "value",
+				},
+			},
+		},
+		"valid scalar SmithyDocument": {
+			val: fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`"value"`, newTestJSONDocument),
+			expected: &testJSONDocument{
+				Value: "value",
+			},
+		},
+		"invalid SmithyDocument": {
+			val:         fwtypes.SmithyDocumentValue[smithyjson.JSONStringer]("not ok", newTestJSONDocument),
+			expectError: true,
+		},
+		"no constructor": {
+			val:         fwtypes.SmithyDocumentValue[smithyjson.JSONStringer](`{"test": "value"}`, nil),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := test.val.ValueSmithyDocument()
+			gotErr := err.HasError()
+
+			if gotErr != test.expectError {
+				t.Errorf("gotErr = %v, wantErr = %v", gotErr, test.expectError)
+			}
+
+			if gotErr {
+				if !test.expectError {
+					t.Errorf("err = %q", err)
+				}
+			} else if diff := cmp.Diff(s, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+)
+
+const (
+	// expiryPrivateStateKey is the private state key under which the expiry time is recorded.
+	expiryPrivateStateKey = "expiry"
+	// renewBeforeExpiry is how long before expiry Terraform is asked to call Renew.
+	renewBeforeExpiry = 2 * time.Minute
+)
+
+// privateState is implemented by ephemeral resource Open and Renew response private state.
+type privateState interface {
+	GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+	SetKey(context.Context, string, []byte) diag.Diagnostics
+}
+
+// WithRenewBeforeExpiry is intended to be embedded in ephemeral resources which return short-lived credentials.
+// The credentials' expiry time is recorded in private state by Open and Terraform calls Renew shortly before that time.
+// Terraform cannot change an ephemeral resource's result values during Renew, so Renew warns that the
+// credentials are about to expire and returns an error once they have expired.
+type WithRenewBeforeExpiry struct{}
+
+// SetExpiry records the credentials' expiry time in private state and returns the time at which Terraform should call Renew.
+func (w *WithRenewBeforeExpiry) SetExpiry(ctx context.Context, private privateState, expiry time.Time) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, err := json.Marshal(expiry.UTC().Format(time.RFC3339))
+	if err != nil {
+		diags.AddError("recording expiry", err.Error())
+		return time.Time{}, diags
+	}
+
+	diags.Append(private.SetKey(ctx, expiryPrivateStateKey, v)...)
+	if diags.HasError() {
+		return time.Time{}, diags
+	}
+
+	return expiry.Add(-renewBeforeExpiry), diags
+}
+
+func (w *WithRenewBeforeExpiry) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	renewAt, diags := renewExpiry(ctx, request.Private, time.Now())
+	response.Diagnostics.Append(diags...)
+	response.RenewAt = renewAt
+}
+
+// renewExpiry checks the expiry time recorded in private state against the current time.
+// It returns the time at which Terraform should next call Renew, or the zero time if no expiry is recorded.
+func renewExpiry(ctx context.Context, private privateState, now time.Time) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, d := private.GetKey(ctx, expiryPrivateStateKey)
+	diags.Append(d...)
+	if diags.HasError() || v == nil {
+		return time.Time{}, diags
+	}
+
+	var s string
+	if err := json.Unmarshal(v, &s); err != nil {
+		diags.AddError("reading expiry", err.Error())
+		return time.Time{}, diags
+	}
+
+	expiry, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		diags.AddError("reading expiry", err.Error())
+		return time.Time{}, diags
+	}
+
+	if !now.Before(expiry) {
+		diags.AddError(
+			"credentials expired",
+			fmt.Sprintf("The credentials expired at %s. Terraform cannot refresh the values of an open ephemeral resource; increase the requested duration to cover the whole operation.", s),
+		)
+		return time.Time{}, diags
+	}
+
+	diags.AddWarning(
+		"credentials expiring",
+		fmt.Sprintf("The credentials expire at %s. Terraform cannot refresh the values of an open ephemeral resource; operations that use them after that time will fail.", s),
+	)
+
+	return expiry, diags
+}
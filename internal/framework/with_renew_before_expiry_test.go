@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestWithRenewBeforeExpirySetExpiry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	expiry := time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("UTC+1", 60*60))
+	private := make(testPrivateState)
+
+	renewAt, diags := (&WithRenewBeforeExpiry{}).SetExpiry(ctx, private, expiry)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := renewAt, expiry.Add(-2*time.Minute); !got.Equal(want) {
+		t.Errorf("RenewAt got: %s, expected: %s", got, want)
+	}
+
+	if got, want := string(private[expiryPrivateStateKey]), `"2024-01-01T11:00:00Z"`; got != want {
+		t.Errorf("private state got: %s, expected: %s", got, want)
+	}
+}
+
+func TestWithRenewBeforeExpiryRenew(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	expiry := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		private         testPrivateState
+		now             time.Time
+		expectedRenewAt time.Time
+		expectWarning   bool
+		expectError     bool
+	}{
+		"no expiry": {
+			private: testPrivateState{},
+			now:     expiry,
+		},
+		"renew before expiry": {
+			private:         testPrivateState{expiryPrivateStateKey: []byte(`"2024-01-01T12:00:00Z"`)},
+			now:             expiry.Add(-2 * time.Minute),
+			expectedRenewAt: expiry,
+			expectWarning:   true,
+		},
+		"at expiry": {
+			private:     testPrivateState{expiryPrivateStateKey: []byte(`"2024-01-01T12:00:00Z"`)},
+			now:         expiry,
+			expectError: true,
+		},
+		"after expiry": {
+			private:     testPrivateState{expiryPrivateStateKey: []byte(`"2024-01-01T12:00:00Z"`)},
+			now:         expiry.Add(time.Second),
+			expectError: true,
+		},
+		"invalid expiry": {
+			private:     testPrivateState{expiryPrivateStateKey: []byte(`"tomorrow"`)},
+			now:         expiry,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			renewAt, diags := renewExpiry(ctx, testCase.private, testCase.now)
+
+			if got, want := diags.HasError(), testCase.expectError; got != want {
+				t.Errorf("error got: %v, expected error: %t", diags.Errors(), want)
+			}
+			if got, want := diags.WarningsCount() > 0, testCase.expectWarning; got != want {
+				t.Errorf("warnings got: %v, expected warning: %t", diags.Warnings(), want)
+			}
+
+			if got, want := renewAt, testCase.expectedRenewAt; !got.Equal(want) {
+				t.Errorf("RenewAt got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
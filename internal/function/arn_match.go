@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const (
+	// arnSectionCount is the number of colon-delimited sections in an ARN
+	arnSectionCount = 6
+)
+
+var _ function.Function = arnMatchFunction{}
+
+func NewARNMatchFunction() function.Function {
+	return &arnMatchFunction{}
+}
+
+type arnMatchFunction struct{}
+
+func (f arnMatchFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_match"
+}
+
+func (f arnMatchFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_match Function",
+		MarkdownDescription: "Checks whether an ARN matches an ARN pattern using IAM policy matching rules. " +
+			"`*` and `?` wildcards in the pattern match within a single ARN section. " +
+			"Policy variables such as `${aws:username}` are not supported.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "ARN pattern, which may contain `*` and `?` wildcards and the `${Partition}` placeholder",
+			},
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to match",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f arnMatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, s string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &s))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := arn.Parse(s); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	result, err := arnMatch(pattern, s)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// arnMatch reports whether an ARN matches an ARN pattern.
+// As in IAM policies, each of the pattern's sections is matched against the
+// corresponding ARN section and wildcards do not match across sections.
+// The resource section is the remainder of the ARN and may contain colons.
+func arnMatch(pattern, s string) (bool, error) {
+	if !strings.HasPrefix(pattern, "arn:") {
+		return false, fmt.Errorf(`pattern must begin with "arn:"`)
+	}
+
+	patternSections := splitARNPattern(pattern)
+	if len(patternSections) != arnSectionCount {
+		return false, fmt.Errorf("pattern must have %d sections", arnSectionCount)
+	}
+
+	sections := strings.SplitN(s, ":", arnSectionCount)
+	if len(sections) != arnSectionCount {
+		return false, nil
+	}
+
+	for i, patternSection := range patternSections {
+		tokens, err := arnPatternTokens(patternSection)
+		if err != nil {
+			return false, err
+		}
+
+		if !wildcardMatch(tokens, sections[i]) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// splitARNPattern splits a pattern into at most arnSectionCount sections.
+// Colons in policy variables such as ${aws:username} do not delimit sections.
+func splitARNPattern(pattern string) []string {
+	var sections []string
+	var start int
+	var inVariable bool
+
+	for i := 0; i < len(pattern) && len(sections) < arnSectionCount-1; i++ {
+		switch {
+		case strings.HasPrefix(pattern[i:], "${"):
+			inVariable = true
+		case pattern[i] == '}':
+			inVariable = false
+		case pattern[i] == ':' && !inVariable:
+			sections = append(sections, pattern[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(sections, pattern[start:])
+}
+
+// arnPatternToken is a literal rune or, if wildcard is set, a '*' or '?' wildcard
+// or a '$' ${Partition} placeholder.
+type arnPatternToken struct {
+	r        rune
+	wildcard bool
+}
+
+// arnPatternTokens splits a pattern section into tokens.
+// The special characters ${*}, ${?} and ${$} are literals and the ${Partition}
+// placeholder used in AWS documentation matches any single path segment.
+// Policy variables such as ${aws:username} are not supported and are an error,
+// as their values are only known when a request is evaluated.
+func arnPatternTokens(section string) ([]arnPatternToken, error) {
+	var tokens []arnPatternToken
+
+	for s := section; len(s) > 0; {
+		if strings.HasPrefix(s, "${") {
+			name, rest, ok := strings.Cut(s[2:], "}")
+			if !ok {
+				return nil, fmt.Errorf("unterminated policy variable in %q", section)
+			}
+
+			switch name {
+			case "*", "?", "$":
+				tokens = append(tokens, arnPatternToken{r: rune(name[0])})
+			case "Partition":
+				tokens = append(tokens, arnPatternToken{r: '$', wildcard: true})
+			default:
+				return nil, fmt.Errorf("policy variable ${%s} is not supported", name)
+			}
+
+			s = rest
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s)
+		tokens = append(tokens, arnPatternToken{r: r, wildcard: r == '*' || r == '?'})
+		s = s[size:]
+	}
+
+	return tokens, nil
+}
+
+// wildcardMatch reports whether s matches the pattern tokens, where '*' matches
+// any sequence of characters, '?' matches any single character and ${Partition}
+// matches one or more characters other than '/' and ':'.
+func wildcardMatch(tokens []arnPatternToken, s string) bool {
+	rs := []rune(s)
+
+	// match[p][i] reports whether tokens[p:] matches rs[i:].
+	match := make([][]bool, len(tokens)+1)
+	for p := range match {
+		match[p] = make([]bool, len(rs)+1)
+	}
+	match[len(tokens)][len(rs)] = true
+
+	for p := len(tokens) - 1; p >= 0; p-- {
+		token := tokens[p]
+
+		for i := len(rs); i >= 0; i-- {
+			switch {
+			case !token.wildcard:
+				match[p][i] = i < len(rs) && rs[i] == token.r && match[p+1][i+1]
+			case token.r == '?':
+				match[p][i] = i < len(rs) && match[p+1][i+1]
+			case token.r == '*':
+				match[p][i] = match[p+1][i] || i < len(rs) && match[p][i+1]
+			default:
+				for j := i; j < len(rs) && rs[j] != '/' && rs[j] != ':'; j++ {
+					if match[p+1][j+1] {
+						match[p][i] = true
+						break
+					}
+				}
+			}
+		}
+	}
+
+	return match[0][0]
+}
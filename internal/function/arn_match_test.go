@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestARNMatchFunction_match(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNMatchFunctionConfig("arn:aws:s3:::logs-*/*", "arn:aws:s3:::logs-prod/2024/01/01/log.gz"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: testARNMatchFunctionConfig("arn:*:iam::*:role/app/*", "arn:aws-us-gov:iam::444455556666:role/app/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: testARNMatchFunctionConfig("arn:aws:iam::44445555666?:role/example", "arn:aws:iam::444455556666:role/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+			{
+				Config: testARNMatchFunctionConfig("arn:${Partition}:iam::*:role/app/*", "arn:aws:iam::444455556666:role/app/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestARNMatchFunction_noMatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNMatchFunctionConfig("arn:aws:s3:::logs-*/*", "arn:aws:s3:::data-prod/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+			{
+				// Wildcards do not match across sections.
+				Config: testARNMatchFunctionConfig("arn:aws:*:::role/example", "arn:aws:iam::444455556666:role/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+			{
+				Config: testARNMatchFunctionConfig("arn:aws:s3:::example/${*}", "arn:aws:s3:::example/object"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+			{
+				// ${Partition} does not match across path segments.
+				Config: testARNMatchFunctionConfig("arn:aws:s3:::${Partition}/example", "arn:aws:s3:::aws/team/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestARNMatchFunction_invalidPattern(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNMatchFunctionConfig("arn:aws:iam::*:role/${aws:username}", "arn:aws:iam::444455556666:role/example"),
+				ExpectError: regexache.MustCompile(`policy[\s\n]*variable[\s\n]*\$\{aws:username\}[\s\n]*is[\s\n]*not[\s\n]*supported`),
+			},
+			{
+				Config:      testARNMatchFunctionConfig("arn:aws:iam::*:role/${aws:username", "arn:aws:iam::444455556666:role/example"),
+				ExpectError: regexache.MustCompile(`unterminated[\s\n]*policy[\s\n]*variable`),
+			},
+			{
+				Config:      testARNMatchFunctionConfig("arn:aws:s3", "arn:aws:s3:::example"),
+				ExpectError: regexache.MustCompile(`pattern[\s\n]*must[\s\n]*have[\s\n]*6[\s\n]*sections`),
+			},
+		},
+	})
+}
+
+func TestARNMatchFunction_invalidARN(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testARNMatchFunctionConfig("arn:aws:s3:::*", "invalid"),
+				ExpectError: regexache.MustCompile(`invalid[\s\n]*prefix`),
+			},
+		},
+	})
+}
+
+// Template sequences in the pattern are escaped so that they are passed literally.
+func testARNMatchFunctionConfig(pattern, arn string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::arn_match(%[1]q, %[2]q)
+}
+`, strings.ReplaceAll(pattern, "${", "$${"), arn)
+}
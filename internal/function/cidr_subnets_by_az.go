@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+var cidrSubnetsByAZTierAttrTypes = map[string]attr.Type{
+	"name":          types.StringType,
+	"prefix_length": types.Int64Type,
+}
+
+var _ function.Function = cidrSubnetsByAZFunction{}
+
+func NewCIDRSubnetsByAZFunction() function.Function {
+	return &cidrSubnetsByAZFunction{}
+}
+
+type cidrSubnetsByAZFunction struct{}
+
+type cidrSubnetsByAZTier struct {
+	Name         string `tfsdk:"name"`
+	PrefixLength int64  `tfsdk:"prefix_length"`
+}
+
+func (f cidrSubnetsByAZFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_subnets_by_az"
+}
+
+func (f cidrSubnetsByAZFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "cidr_subnets_by_az Function",
+		MarkdownDescription: "Divides a CIDR block into non-overlapping subnets, one per tier per Availability Zone. " +
+			"Subnets are allocated largest first, and subnets of the same size in tier order, then Availability Zone order, so the result is deterministic for a given set of arguments.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "IPv4 or IPv6 CIDR block to divide, typically the CIDR block of a VPC",
+			},
+			function.ListParameter{
+				Name:                "availability_zones",
+				MarkdownDescription: "Availability Zone names",
+				ElementType:         types.StringType,
+			},
+			function.ListParameter{
+				Name:                "tiers",
+				MarkdownDescription: "Subnet tiers, each with a unique `name` and the `prefix_length` of the subnets in that tier",
+				ElementType: types.ObjectType{
+					AttrTypes: cidrSubnetsByAZTierAttrTypes,
+				},
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.MapType{
+				ElemType: types.StringType,
+			},
+		},
+	}
+}
+
+func (f cidrSubnetsByAZFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var azs []string
+	var tiers []cidrSubnetsByAZTier
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &azs, &tiers))
+	if resp.Error != nil {
+		return
+	}
+
+	plan, err := planSubnetsByAZ(cidr, azs, tiers)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, plan))
+}
+
+// planSubnetsByAZ allocates one subnet per tier per Availability Zone from the
+// specified CIDR block. Subnets are packed largest first, and subnets of the same
+// size in tier order, then Availability Zone order. Each subnet is then aligned on
+// its own size boundary without any gaps, so the subnets fit whenever their total
+// size is no larger than the CIDR block.
+func planSubnetsByAZ(cidr string, azs []string, tiers []cidrSubnetsByAZTier) (map[string]map[string]string, error) {
+	if err := itypes.ValidateCIDRBlock(cidr); err != nil {
+		return nil, err
+	}
+
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(azs) == 0 {
+		return nil, fmt.Errorf("at least one Availability Zone must be specified")
+	}
+
+	seen := make(map[string]struct{}, len(azs))
+	for _, az := range azs {
+		if az == "" {
+			return nil, fmt.Errorf("empty Availability Zone name")
+		}
+		if _, ok := seen[az]; ok {
+			return nil, fmt.Errorf("duplicate Availability Zone %q", az)
+		}
+		seen[az] = struct{}{}
+	}
+
+	base := ipnet.IP
+	if v := base.To4(); v != nil {
+		base = v
+	}
+	ones, bits := ipnet.Mask.Size()
+
+	type allocation struct {
+		tier         string
+		az           string
+		prefixLength int64
+	}
+	var allocations []allocation
+
+	result := make(map[string]map[string]string, len(tiers))
+	for _, tier := range tiers {
+		if tier.Name == "" {
+			return nil, fmt.Errorf("empty tier name")
+		}
+		if _, ok := result[tier.Name]; ok {
+			return nil, fmt.Errorf("duplicate tier %q", tier.Name)
+		}
+		if tier.PrefixLength < int64(ones) || tier.PrefixLength > int64(bits) {
+			return nil, fmt.Errorf("tier %q: prefix length %d must be between %d and %d", tier.Name, tier.PrefixLength, ones, bits)
+		}
+
+		result[tier.Name] = make(map[string]string, len(azs))
+
+		for _, az := range azs {
+			allocations = append(allocations, allocation{
+				tier:         tier.Name,
+				az:           az,
+				prefixLength: tier.PrefixLength,
+			})
+		}
+	}
+
+	// Shortest prefix length first. The sort is stable, so ties remain in tier, then Availability Zone, order.
+	slices.SortStableFunc(allocations, func(a, b allocation) int {
+		return cmp.Compare(a.prefixLength, b.prefixLength)
+	})
+
+	start := new(big.Int).SetBytes(base)
+	end := new(big.Int).Add(start, new(big.Int).Lsh(big.NewInt(1), uint(bits-ones)))
+	next := new(big.Int).Set(start)
+
+	for _, a := range allocations {
+		// As subnets are allocated in non-increasing size order from an aligned start, next is always aligned on the subnet size.
+		size := new(big.Int).Lsh(big.NewInt(1), uint(int64(bits)-a.prefixLength))
+
+		if new(big.Int).Add(next, size).Cmp(end) > 0 {
+			return nil, fmt.Errorf("tier %q: no room for a /%d subnet in Availability Zone %q within %s", a.tier, a.prefixLength, a.az, ipnet)
+		}
+
+		subnet := net.IPNet{
+			IP:   bigIntToIP(next, len(base)),
+			Mask: net.CIDRMask(int(a.prefixLength), bits),
+		}
+		result[a.tier][a.az] = subnet.String()
+
+		next.Add(next, size)
+	}
+
+	return result, nil
+}
+
+func bigIntToIP(v *big.Int, n int) net.IP {
+	return net.IP(v.FillBytes(make([]byte, n)))
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestCIDRSubnetsByAZFunction_ipv4(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsByAZFunctionConfig("10.0.0.0/16", `{ name = "public", prefix_length = 24 }, { name = "private", prefix_length = 20 }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public_a", "10.0.32.0/24"),
+					resource.TestCheckOutput("public_b", "10.0.33.0/24"),
+					resource.TestCheckOutput("private_a", "10.0.0.0/20"),
+					resource.TestCheckOutput("private_b", "10.0.16.0/20"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsByAZFunction_ipv6(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsByAZFunctionConfig("2600:1f14:abc:de00::/56", `{ name = "public", prefix_length = 64 }, { name = "private", prefix_length = 64 }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public_a", "2600:1f14:abc:de00::/64"),
+					resource.TestCheckOutput("public_b", "2600:1f14:abc:de01::/64"),
+					resource.TestCheckOutput("private_a", "2600:1f14:abc:de02::/64"),
+					resource.TestCheckOutput("private_b", "2600:1f14:abc:de03::/64"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsByAZFunction_largestFirst(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testCIDRSubnetsByAZFunctionConfig_singleAZ("10.0.0.0/24", `{ name = "public", prefix_length = 26 }, { name = "private", prefix_length = 25 }, { name = "database", prefix_length = 26 }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("public", "10.0.0.128/26"),
+					resource.TestCheckOutput("private", "10.0.0.0/25"),
+					resource.TestCheckOutput("database", "10.0.0.192/26"),
+				),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsByAZFunction_doesNotFit(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsByAZFunctionConfig("10.0.0.0/24", `{ name = "public", prefix_length = 25 }, { name = "private", prefix_length = 25 }`),
+				ExpectError: regexache.MustCompile(`no[\s\n]*room`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsByAZFunction_invalidPrefixLength(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsByAZFunctionConfig("10.0.0.0/16", `{ name = "public", prefix_length = 8 }`),
+				ExpectError: regexache.MustCompile(`prefix[\s\n]*length[\s\n]*8[\s\n]*must`),
+			},
+		},
+	})
+}
+
+func TestCIDRSubnetsByAZFunction_invalidCIDR(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testCIDRSubnetsByAZFunctionConfig("10.0.0.1/16", `{ name = "public", prefix_length = 24 }`),
+				ExpectError: regexache.MustCompile(`not[\s\n]*a[\s\n]*valid[\s\n]*CIDR[\s\n]*block`),
+			},
+		},
+	})
+}
+
+func testCIDRSubnetsByAZFunctionConfig(cidr, tiers string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::cidr_subnets_by_az(%[1]q, ["us-west-2a", "us-west-2b"], [%[2]s])
+}
+
+output "public_a" {
+  value = local.result["public"]["us-west-2a"]
+}
+
+output "public_b" {
+  value = local.result["public"]["us-west-2b"]
+}
+
+output "private_a" {
+  value = try(local.result["private"]["us-west-2a"], null)
+}
+
+output "private_b" {
+  value = try(local.result["private"]["us-west-2b"], null)
+}
+`, cidr, tiers)
+}
+
+func testCIDRSubnetsByAZFunctionConfig_singleAZ(cidr, tiers string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::cidr_subnets_by_az(%[1]q, ["us-west-2a"], [%[2]s])
+}
+
+output "public" {
+  value = local.result["public"]["us-west-2a"]
+}
+
+output "private" {
+  value = local.result["private"]["us-west-2a"]
+}
+
+output "database" {
+  value = local.result["database"]["us-west-2a"]
+}
+`, cidr, tiers)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = dnsSuffixFunction{}
+
+func NewDNSSuffixFunction() function.Function {
+	return &dnsSuffixFunction{}
+}
+
+type dnsSuffixFunction struct{}
+
+func (f dnsSuffixFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dns_suffix"
+}
+
+func (f dnsSuffixFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "dns_suffix Function",
+		MarkdownDescription: "Returns the DNS suffix of the partition containing an AWS Region",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "AWS Region name",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f dnsSuffixFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	partition, err := partitionForRegion(region)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, partition.DNSSuffix()))
+}
+
+// partitionForRegion returns the partition containing the specified Region.
+// Unlike names.PartitionForRegion, an unknown Region is an error.
+func partitionForRegion(region string) (endpoints.Partition, error) {
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !ok {
+		return endpoints.Partition{}, fmt.Errorf("unknown region %q", region)
+	}
+
+	return partition, nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestDNSSuffixFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDNSSuffixFunctionConfig("us-west-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "amazonaws.com"),
+				),
+			},
+			{
+				Config: testDNSSuffixFunctionConfig("cn-north-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "amazonaws.com.cn"),
+				),
+			},
+			{
+				Config: testDNSSuffixFunctionConfig("us-gov-west-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "amazonaws.com"),
+				),
+			},
+			{
+				Config: testDNSSuffixFunctionConfig("us-iso-east-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "c2s.ic.gov"),
+				),
+			},
+		},
+	})
+}
+
+func TestDNSSuffixFunction_unknownRegion(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testDNSSuffixFunctionConfig("mars-north-1"),
+				ExpectError: regexache.MustCompile(`unknown[\s\n]*region`),
+			},
+		},
+	})
+}
+
+func testDNSSuffixFunctionConfig(region string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::dns_suffix(%[1]q)
+}
+`, region)
+}
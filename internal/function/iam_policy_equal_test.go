@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestIAMPolicyEqualFunction_equivalent(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEqualFunctionConfig(
+					`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+					`{"Version":"2012-10-17","Statement":{"Resource":["*"],"Action":["s3:PutObject","s3:GetObject"],"Effect":"Allow"}}`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEqualFunction_different(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyEqualFunctionConfig(
+					`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+					`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEqualFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyEqualFunctionConfig(`{}`, `{"Version":`),
+				ExpectError: regexache.MustCompile(`policy2[\s\n]*is[\s\n]*invalid[\s\n]*JSON`),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyEqualFunction_agreesWithNormalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		policy1  string
+		policy2  string
+		expected string
+	}{
+		{
+			policy1:  ``,
+			policy2:  `{}`,
+			expected: "true",
+		},
+		{
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"123456789012"}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"allow","Action":["sts:AssumeRole"],"Principal":{"AWS":["arn:aws:iam::123456789012:root"]}}]}`,
+			expected: "true",
+		},
+		{
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Principal":{"AWS":"123456789012"},"Resource":"arn:aws-cn:s3:::b/*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Principal":{"AWS":"arn:aws-cn:iam::123456789012:root"},"Resource":"arn:aws-cn:s3:::b/*"}]}`,
+			expected: "true",
+		},
+		{
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Principal":{"AWS":"arn:aws-us-gov:iam::123456789012:root"},"Resource":"*"}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Resource":"*"}]}`,
+			expected: "true",
+		},
+		{
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"123456789012"},"Condition":{"Bool":{"aws:MultiFactorAuthPresent":true}}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"123456789012"},"Condition":{"Bool":{"aws:MultiFactorAuthPresent":"true"}}}]}`,
+			expected: "true",
+		},
+		{
+			policy1:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"123456789012"}}]}`,
+			policy2:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"111122223333"}}]}`,
+			expected: "false",
+		},
+	}
+
+	steps := make([]resource.TestStep, 0, len(testCases))
+	for _, tc := range testCases {
+		steps = append(steps, resource.TestStep{
+			Config: testIAMPolicyEqualFunctionConfig_normalize(tc.policy1, tc.policy2),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckOutput("test", tc.expected),
+				resource.TestCheckOutput("normalized", tc.expected),
+			),
+		})
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: steps,
+	})
+}
+
+func testIAMPolicyEqualFunctionConfig(policy1, policy2 string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_equal(%[1]q, %[2]q)
+}
+`, policy1, policy2)
+}
+
+func testIAMPolicyEqualFunctionConfig_normalize(policy1, policy2 string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_equal(%[1]q, %[2]q)
+}
+
+output "normalized" {
+  value = provider::aws::iam_policy_normalize(%[1]q) == provider::aws::iam_policy_normalize(%[2]q)
+}
+`, policy1, policy2)
+}
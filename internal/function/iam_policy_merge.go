@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = iamPolicyMergeFunction{}
+
+func NewIAMPolicyMergeFunction() function.Function {
+	return &iamPolicyMergeFunction{}
+}
+
+type iamPolicyMergeFunction struct{}
+
+func (f iamPolicyMergeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "iam_policy_merge"
+}
+
+func (f iamPolicyMergeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "iam_policy_merge Function",
+		MarkdownDescription: "Merges IAM policy documents into a single policy document. " +
+			"Identical statements are included once. Statements with the same `Sid` but different content are an error unless `override` is `true`, " +
+			"in which case the statement from the later document replaces the earlier one.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "policies",
+				MarkdownDescription: "IAM policy document JSON strings, in merge order",
+				ElementType:         types.StringType,
+			},
+			function.BoolParameter{
+				Name:                "override",
+				MarkdownDescription: "Whether statements in later documents replace statements with the same `Sid` in earlier documents",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f iamPolicyMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policies []string
+	var override bool
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &policies, &override))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := mergePolicies(policies, override)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// mergePolicies merges policy documents in order using the same rules as the
+// aws_iam_policy_document data source's source_policy_documents and
+// override_policy_documents arguments.
+func mergePolicies(policies []string, override bool) (string, error) {
+	mergedDoc := &iampolicy.Document{}
+	// Canonical JSON of each statement in the merged document, keyed by Sid.
+	sids := make(map[string]string)
+	// Canonical JSON of each statement without a Sid in the merged document.
+	anonymous := make(map[string]struct{})
+
+	for i, policy := range policies {
+		if strings.TrimSpace(policy) == "" {
+			continue
+		}
+
+		doc := &iampolicy.Document{}
+		if err := json.Unmarshal([]byte(policy), doc); err != nil {
+			return "", fmt.Errorf("merging policy document %d: %w", i, err)
+		}
+
+		statements := make([]*iampolicy.Statement, 0, len(doc.Statements))
+		for j, stmt := range doc.Statements {
+			key, err := policyStatementKey(stmt)
+			if err != nil {
+				return "", fmt.Errorf("merging policy document %d: statement %d: %w", i, j, err)
+			}
+
+			if stmt.Sid == "" {
+				if _, ok := anonymous[key]; ok {
+					continue
+				}
+				anonymous[key] = struct{}{}
+			} else {
+				if existing, ok := sids[stmt.Sid]; ok {
+					if existing == key {
+						continue
+					}
+					if !override {
+						return "", fmt.Errorf("merging policy document %d: duplicate Sid (%s) with different content (statement %d); remove the Sid, ensure Sids are unique or set override to true", i, stmt.Sid, j)
+					}
+				}
+				sids[stmt.Sid] = key
+			}
+
+			statements = append(statements, stmt)
+		}
+		doc.Statements = statements
+
+		mergedDoc.Merge(doc)
+	}
+
+	b, err := json.Marshal(mergedDoc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// policyStatementKey returns a key that is equal for semantically equivalent statements.
+func policyStatementKey(stmt *iampolicy.Statement) (string, error) {
+	b, err := json.Marshal(&iampolicy.Document{Statements: []*iampolicy.Statement{stmt}})
+	if err != nil {
+		return "", err
+	}
+
+	return verify.PolicyCanonicalize(string(b))
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+const (
+	testIAMPolicyMergeFunctionPolicyRead = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "Read", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"},
+    {"Effect": "Deny", "Action": "s3:DeleteBucket", "Resource": "*"}
+  ]
+}`
+	testIAMPolicyMergeFunctionPolicyWrite = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "Write", "Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"},
+    {"Effect": "Deny", "Action": "s3:DeleteBucket", "Resource": "*"}
+  ]
+}`
+	testIAMPolicyMergeFunctionPolicyTrustAccountID = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "Trust", "Effect": "Allow", "Action": "sts:AssumeRole", "Principal": {"AWS": "123456789012"}}
+  ]
+}`
+	testIAMPolicyMergeFunctionPolicyTrustRootARN = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "Trust", "Effect": "allow", "Action": "sts:AssumeRole", "Principal": {"AWS": ["arn:aws:iam::123456789012:root"]}}
+  ]
+}`
+	testIAMPolicyMergeFunctionPolicyTrustMFA = `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Sid": "TrustMFA",
+    "Effect": "Allow",
+    "Action": "sts:AssumeRole",
+    "Principal": {"AWS": [123456789012]},
+    "Condition": {"NumericLessThan": {"aws:MultiFactorAuthAge": 3600}, "Bool": {"aws:MultiFactorAuthPresent": true}}
+  }
+}`
+	testIAMPolicyMergeFunctionPolicyReadConflict = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {"Sid": "Read", "Effect": "Allow", "Action": ["s3:GetObject", "s3:ListBucket"], "Resource": "*"}
+  ]
+}`
+)
+
+func TestIAMPolicyMergeFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(false, testIAMPolicyMergeFunctionPolicyRead, testIAMPolicyMergeFunctionPolicyWrite),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteBucket","Resource":"*"},{"Sid":"Write","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_duplicateSid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig(false, testIAMPolicyMergeFunctionPolicyRead, testIAMPolicyMergeFunctionPolicyReadConflict),
+				ExpectError: regexache.MustCompile(`duplicate[\s\n]*Sid[\s\n]*\(Read\)`),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_equivalentSid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(false, testIAMPolicyMergeFunctionPolicyTrustAccountID, testIAMPolicyMergeFunctionPolicyTrustRootARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"Trust","Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"123456789012"}}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_scalarValues(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(false, testIAMPolicyMergeFunctionPolicyRead, testIAMPolicyMergeFunctionPolicyTrustMFA),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteBucket","Resource":"*"},{"Sid":"TrustMFA","Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":["123456789012"]},"Condition":{"Bool":{"aws:MultiFactorAuthPresent":"true"},"NumericLessThan":{"aws:MultiFactorAuthAge":"3600"}}}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_override(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIAMPolicyMergeFunctionConfig(true, testIAMPolicyMergeFunctionPolicyRead, testIAMPolicyMergeFunctionPolicyReadConflict),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Sid":"Read","Effect":"Allow","Action":["s3:GetObject","s3:ListBucket"],"Resource":"*"},{"Effect":"Deny","Action":"s3:DeleteBucket","Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestIAMPolicyMergeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testIAMPolicyMergeFunctionConfig(false, testIAMPolicyMergeFunctionPolicyRead, `{"Version":`),
+				ExpectError: regexache.MustCompile(`merging[\s\n]*policy[\s\n]*document[\s\n]*1`),
+			},
+		},
+	})
+}
+
+func testIAMPolicyMergeFunctionConfig(override bool, policies ...string) string {
+	args := make([]string, len(policies))
+	for i, v := range policies {
+		args[i] = fmt.Sprintf("%q", v)
+	}
+
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::iam_policy_merge([%[1]s], %[2]t)
+}
+`, strings.Join(args, ", "), override)
+}
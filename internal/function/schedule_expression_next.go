@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+const (
+	// scheduleExpressionNextMaxCount is the maximum number of fire times returned
+	scheduleExpressionNextMaxCount = 100
+)
+
+var _ function.Function = scheduleExpressionNextFunction{}
+
+func NewScheduleExpressionNextFunction() function.Function {
+	return &scheduleExpressionNextFunction{}
+}
+
+type scheduleExpressionNextFunction struct{}
+
+func (f scheduleExpressionNextFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_expression_next"
+}
+
+func (f scheduleExpressionNextFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "schedule_expression_next Function",
+		MarkdownDescription: "Parses an Amazon EventBridge or EventBridge Scheduler schedule expression and returns the next fire times " +
+			"after a start time, as RFC3339 timestamps",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression, one of `cron(...)`, `rate(...)` or `at(...)`",
+			},
+			function.StringParameter{
+				Name:                "start_time",
+				MarkdownDescription: "RFC3339 timestamp after which to return fire times",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: fmt.Sprintf("Maximum number of fire times to return, between 1 and %d", scheduleExpressionNextMaxCount),
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "timezone",
+			MarkdownDescription: "IANA time zone in which to evaluate the schedule expression. Defaults to `UTC`",
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f scheduleExpressionNextFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, startTime string
+	var count int64
+	var timezones []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &startTime, &count, &timezones))
+	if resp.Error != nil {
+		return
+	}
+
+	if count < 1 || count > scheduleExpressionNextMaxCount {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(2, fmt.Sprintf("count must be between 1 and %d", scheduleExpressionNextMaxCount)))
+		return
+	}
+
+	loc := time.UTC
+	switch len(timezones) {
+	case 0:
+	case 1:
+		v, err := time.LoadLocation(timezones[0])
+		if err != nil {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(3, err.Error()))
+			return
+		}
+		loc = v
+	default:
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(4, "at most one timezone may be specified"))
+		return
+	}
+
+	start, err := time.Parse(time.RFC3339, startTime)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	expr, err := schedule.Parse(expression)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result := make([]string, 0, count)
+	for t := start.In(loc); int64(len(result)) < count; {
+		next, ok := expr.Next(t)
+		if !ok {
+			break
+		}
+		result = append(result, next.Format(time.RFC3339))
+		t = next
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
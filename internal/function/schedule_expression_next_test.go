@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestScheduleExpressionNextFunction_cron(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(0 9 ? * 6L *)", "2024-02-27T10:30:00Z", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("first", "2024-03-29T09:00:00Z"),
+					resource.TestCheckOutput("second", "2024-04-26T09:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_rate(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("rate(12 hours)", "2024-02-27T10:30:00Z", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("first", "2024-02-27T22:30:00Z"),
+					resource.TestCheckOutput("second", "2024-02-28T10:30:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_timezone(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfigTimezone("cron(0 9 * * ? *)", "2024-02-27T10:30:00Z", 2, "America/Vancouver"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("first", "2024-02-28T09:00:00-08:00"),
+					resource.TestCheckOutput("second", "2024-02-29T09:00:00-08:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("cron(0 9 * * * *)", "2024-02-27T10:30:00Z", 2),
+				ExpectError: regexache.MustCompile(`exactly[\s\n]*one[\s\n]*of[\s\n]*day-of-month[\s\n]*or[\s\n]*day-of-week`),
+			},
+		},
+	})
+}
+
+func testScheduleExpressionNextFunctionConfig(expression, startTime string, count int) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::schedule_expression_next(%[1]q, %[2]q, %[3]d)
+}
+
+output "first" {
+  value = local.result[0]
+}
+
+output "second" {
+  value = local.result[1]
+}
+`, expression, startTime, count)
+}
+
+func testScheduleExpressionNextFunctionConfigTimezone(expression, startTime string, count int, timezone string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::schedule_expression_next(%[1]q, %[2]q, %[3]d, %[4]q)
+}
+
+output "first" {
+  value = local.result[0]
+}
+
+output "second" {
+  value = local.result[1]
+}
+`, expression, startTime, count, timezone)
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestServicePrincipalFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testServicePrincipalFunctionConfig("logs", "us-west-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "logs.amazonaws.com"),
+				),
+			},
+			{
+				Config: testServicePrincipalFunctionConfig("logs", "cn-north-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "logs.amazonaws.com.cn"),
+				),
+			},
+			{
+				Config: testServicePrincipalFunctionConfig("ec2", "cn-north-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ec2.amazonaws.com"),
+				),
+			},
+			{
+				Config: testServicePrincipalFunctionConfig("config", "us-iso-east-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "config.c2s.ic.gov"),
+				),
+			},
+		},
+	})
+}
+
+func TestServicePrincipalFunction_unknownRegion(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testServicePrincipalFunctionConfig("logs", "mars-north-1"),
+				ExpectError: regexache.MustCompile(`unknown[\s\n]*region`),
+			},
+		},
+	})
+}
+
+func testServicePrincipalFunctionConfig(service, region string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::service_principal(%[1]q, %[2]q)
+}
+`, service, region)
+}
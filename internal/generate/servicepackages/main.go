@@ -20,6 +20,7 @@ import (
 
 func main() {
 	filename := `service_packages_gen.go`
+	serviceDir := flag.String("service-dir", "../service", "path to the internal/service directory")
 
 	flag.Parse()
 	args := flag.Args()
@@ -47,7 +48,7 @@ func main() {
 		// See internal/generate/namesconsts/main.go.
 		p := l.ProviderPackage()
 
-		spdFile := fmt.Sprintf("../service/%s/service_package_gen.go", p)
+		spdFile := fmt.Sprintf("%s/%s/service_package_gen.go", *serviceDir, p)
 
 		if _, err := os.Stat(spdFile); err != nil {
 			continue
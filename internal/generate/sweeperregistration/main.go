@@ -10,6 +10,7 @@ import (
 	"cmp"
 	_ "embed"
 	"errors"
+	"flag"
 	"fmt"
 	"io/fs"
 	"os"
@@ -29,9 +30,15 @@ type TemplateData struct {
 }
 
 func main() {
-	const (
-		filename = `register_gen_test.go`
-	)
+	filename := `register_gen_test.go`
+	serviceDir := flag.String("service-dir", "../service", "path to the internal/service directory")
+
+	flag.Parse()
+	args := flag.Args()
+	if len(args) > 0 {
+		filename = args[0]
+	}
+
 	g := common.NewGenerator()
 
 	packageName := os.Getenv("GOPACKAGE")
@@ -55,11 +62,11 @@ func main() {
 
 		p := l.ProviderPackage()
 
-		if _, err := os.Stat(fmt.Sprintf("../service/%s", p)); err != nil || errors.Is(err, fs.ErrNotExist) {
+		if _, err := os.Stat(fmt.Sprintf("%s/%s", *serviceDir, p)); err != nil || errors.Is(err, fs.ErrNotExist) {
 			continue
 		}
 
-		if _, err := os.Stat(fmt.Sprintf("../service/%s/sweep.go", p)); err != nil || errors.Is(err, fs.ErrNotExist) {
+		if _, err := os.Stat(fmt.Sprintf("%s/%s/sweep.go", *serviceDir, p)); err != nil || errors.Is(err, fs.ErrNotExist) {
 			g.Infof("No sweepers for %q", p)
 			continue
 		}
@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package iampolicy models IAM policy documents.
+package iampolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+const (
+	marshallJSONStartSliceSize = 2
+)
+
+// Document is an IAM policy document.
+type Document struct {
+	Version    string       `json:",omitempty"`
+	Id         string       `json:",omitempty"`
+	Statements []*Statement `json:"Statement,omitempty"`
+}
+
+// Statement is a statement in an IAM policy document.
+type Statement struct {
+	Sid           string       `json:",omitempty"`
+	Effect        string       `json:",omitempty"`
+	Actions       interface{}  `json:"Action,omitempty"`
+	NotActions    interface{}  `json:"NotAction,omitempty"`
+	Resources     interface{}  `json:"Resource,omitempty"`
+	NotResources  interface{}  `json:"NotResource,omitempty"`
+	Principals    PrincipalSet `json:"Principal,omitempty"`
+	NotPrincipals PrincipalSet `json:"NotPrincipal,omitempty"`
+	Conditions    ConditionSet `json:"Condition,omitempty"`
+}
+
+// Principal is a Principal or NotPrincipal element of a policy statement.
+type Principal struct {
+	Type        string
+	Identifiers interface{}
+}
+
+// Condition is a condition of a policy statement.
+type Condition struct {
+	Test     string
+	Variable string
+	Values   interface{}
+}
+
+type PrincipalSet []Principal
+type ConditionSet []Condition
+
+// Merge merges newDoc into the document.
+// Statements in newDoc replace statements with the same Sid and other statements are appended.
+func (s *Document) Merge(newDoc *Document) {
+	// adopt newDoc's Id
+	if len(newDoc.Id) > 0 {
+		s.Id = newDoc.Id
+	}
+
+	// let newDoc upgrade our Version
+	if newDoc.Version > s.Version {
+		s.Version = newDoc.Version
+	}
+
+	// merge in newDoc's statements, overwriting any existing Sids
+	var seen bool
+	for _, newStatement := range newDoc.Statements {
+		if len(newStatement.Sid) == 0 {
+			s.Statements = append(s.Statements, newStatement)
+			continue
+		}
+		seen = false
+		for i, existingStatement := range s.Statements {
+			if existingStatement.Sid == newStatement.Sid {
+				s.Statements[i] = newStatement
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			s.Statements = append(s.Statements, newStatement)
+		}
+	}
+}
+
+// UnmarshalJSON accepts a Statement element that is either a single statement or an array of statements.
+func (s *Document) UnmarshalJSON(b []byte) error {
+	type document Document
+	var data struct {
+		document
+		Statements json.RawMessage `json:"Statement,omitempty"`
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	out := Document(data.document)
+	if v := bytes.TrimSpace(data.Statements); bytes.HasPrefix(v, []byte("{")) {
+		var statement Statement
+		if err := json.Unmarshal(v, &statement); err != nil {
+			return err
+		}
+		out.Statements = []*Statement{&statement}
+	} else if len(v) > 0 {
+		if err := json.Unmarshal(v, &out.Statements); err != nil {
+			return err
+		}
+	}
+
+	*s = out
+	return nil
+}
+
+func (ps PrincipalSet) MarshalJSON() ([]byte, error) {
+	raw := map[string]interface{}{}
+
+	// Although IAM documentation says that "*" and {"AWS": "*"} are equivalent
+	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_principal.html),
+	// in practice they are not for IAM roles. IAM will return an error if trust
+	// policy have "*" or {"*": "*"} as principal, but will accept {"AWS": "*"}.
+	// Only {"*": "*"} should be normalized to "*".
+	if len(ps) == 1 {
+		p := ps[0]
+		if p.Type == "*" {
+			if sv, ok := p.Identifiers.(string); ok && sv == "*" {
+				return []byte(`"*"`), nil
+			}
+
+			if av, ok := p.Identifiers.([]string); ok && len(av) == 1 && av[0] == "*" {
+				return []byte(`"*"`), nil
+			}
+		}
+	}
+
+	for _, p := range ps {
+		switch i := p.Identifiers.(type) {
+		case []string:
+			switch v := raw[p.Type].(type) {
+			case nil:
+				raw[p.Type] = make([]string, 0, len(i))
+			case string:
+				// Convert to []string to prevent panic
+				raw[p.Type] = make([]string, 0, len(i)+1)
+				raw[p.Type] = append(raw[p.Type].([]string), v)
+			}
+			slices.Sort(i)
+			slices.Reverse(i)
+			raw[p.Type] = append(raw[p.Type].([]string), i...)
+		case string:
+			switch v := raw[p.Type].(type) {
+			case nil:
+				raw[p.Type] = i
+			case string:
+				// Convert to []string to stop drop of principals
+				raw[p.Type] = make([]string, 0, marshallJSONStartSliceSize)
+				raw[p.Type] = append(raw[p.Type].([]string), v)
+				raw[p.Type] = append(raw[p.Type].([]string), i)
+			case []string:
+				raw[p.Type] = append(raw[p.Type].([]string), i)
+			}
+		default:
+			return []byte{}, fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet", i)
+		}
+	}
+
+	return json.Marshal(&raw)
+}
+
+func (ps *PrincipalSet) UnmarshalJSON(b []byte) error {
+	var out PrincipalSet
+
+	var data interface{}
+	if err := unmarshalJSONUseNumber(b, &data); err != nil {
+		return err
+	}
+
+	switch t := data.(type) {
+	case string:
+		out = append(out, Principal{Type: "*", Identifiers: []string{"*"}})
+	case map[string]interface{}:
+		for key, value := range t {
+			switch vt := value.(type) {
+			case []interface{}:
+				values, err := scalarStrings(vt)
+				if err != nil {
+					return fmt.Errorf("Unsupported data type for IAMPolicyStatementPrincipalSet.Identifiers: %w", err)
+				}
+				slices.Sort(values)
+				out = append(out, Principal{Type: key, Identifiers: values})
+			default:
+				v, err := scalarString(vt)
+				if err != nil {
+					return fmt.Errorf("Unsupported data type for IAMPolicyStatementPrincipalSet.Identifiers: %w", err)
+				}
+				out = append(out, Principal{Type: key, Identifiers: v})
+			}
+		}
+	default:
+		return fmt.Errorf("Unsupported data type %T for IAMPolicyStatementPrincipalSet", t)
+	}
+
+	*ps = out
+	return nil
+}
+
+func (cs ConditionSet) MarshalJSON() ([]byte, error) {
+	raw := map[string]map[string]interface{}{}
+
+	for _, c := range cs {
+		if _, ok := raw[c.Test]; !ok {
+			raw[c.Test] = map[string]interface{}{}
+		}
+		if _, ok := raw[c.Test][c.Variable]; !ok {
+			raw[c.Test][c.Variable] = []string{}
+		}
+		switch i := c.Values.(type) {
+		case []string:
+			// order matters with values so not sorting here
+			raw[c.Test][c.Variable] = append(raw[c.Test][c.Variable].([]string), i...)
+		case string:
+			raw[c.Test][c.Variable] = append(raw[c.Test][c.Variable].([]string), i)
+		default:
+			return nil, fmt.Errorf("Unsupported data type for IAMPolicyStatementConditionSet: %s", i)
+		}
+	}
+
+	// flatten entries with a single item to match AWS IAM syntax
+	for k1 := range raw {
+		for k2 := range raw[k1] {
+			items := raw[k1][k2].([]string)
+			if len(items) == 1 {
+				raw[k1][k2] = items[0]
+			}
+		}
+	}
+
+	return json.Marshal(&raw)
+}
+
+func (cs *ConditionSet) UnmarshalJSON(b []byte) error {
+	var out ConditionSet
+
+	var data map[string]map[string]interface{}
+	if err := unmarshalJSONUseNumber(b, &data); err != nil {
+		return err
+	}
+
+	for test_key, test_value := range data {
+		for var_key, var_values := range test_value {
+			var values []string
+			switch var_values := var_values.(type) {
+			case []interface{}:
+				v, err := scalarStrings(var_values)
+				if err != nil {
+					return fmt.Errorf("Unsupported data type for IAMPolicyStatementConditionSet (%s: %s): %w", test_key, var_key, err)
+				}
+				values = v
+			default:
+				v, err := scalarString(var_values)
+				if err != nil {
+					return fmt.Errorf("Unsupported data type for IAMPolicyStatementConditionSet (%s: %s): %w", test_key, var_key, err)
+				}
+				values = []string{v}
+			}
+			out = append(out, Condition{Test: test_key, Variable: var_key, Values: values})
+		}
+	}
+
+	*cs = out
+	return nil
+}
+
+// unmarshalJSONUseNumber decodes JSON, keeping numbers as json.Number rather than converting them to float64.
+func unmarshalJSONUseNumber(b []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	return dec.Decode(v)
+}
+
+// scalarString returns the string form of a decoded JSON string, number or boolean.
+func scalarString(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		return "", fmt.Errorf("%T", v)
+	}
+}
+
+// scalarStrings returns the string forms of a decoded JSON array of strings, numbers or booleans.
+func scalarStrings(v []interface{}) ([]string, error) {
+	values := make([]string, 0, len(v))
+	for _, v := range v {
+		s, err := scalarString(v)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, s)
+	}
+
+	return values, nil
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+func TestConditionSetMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		cs      iampolicy.ConditionSet
+		want    []byte
+		wantErr bool
+	}{
+		"invalid value type": {
+			cs: iampolicy.ConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: 1},
+			},
+			wantErr: true,
+		},
+		"single condition single value": {
+			cs: iampolicy.ConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: "one/"},
+			},
+			want: []byte(`{"StringLike":{"s3:prefix":"one/"}}`),
+		},
+		"single condition multiple values": {
+			cs: iampolicy.ConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
+			},
+			want: []byte(`{"StringLike":{"s3:prefix":["one/","two/"]}}`),
+		},
+		// Multiple distinct conditions
+		"multiple condition single value": {
+			cs: iampolicy.ConditionSet{
+				{Test: "ArnNotLike", Variable: "aws:PrincipalArn", Values: "1"},
+				{Test: "StringLike", Variable: "s3:prefix", Values: "one/"},
+			},
+			want: []byte(`{"ArnNotLike":{"aws:PrincipalArn":"1"},"StringLike":{"s3:prefix":"one/"}}`),
+		},
+		"multiple condition multiple values": {
+			cs: iampolicy.ConditionSet{
+				{Test: "ArnNotLike", Variable: "aws:PrincipalArn", Values: []string{"1", "2"}},
+				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
+			},
+			want: []byte(`{"ArnNotLike":{"aws:PrincipalArn":["1","2"]},"StringLike":{"s3:prefix":["one/","two/"]}}`),
+		},
+		"multiple condition mixed value lengths": {
+			cs: iampolicy.ConditionSet{
+				{Test: "ArnNotLike", Variable: "aws:PrincipalArn", Values: "1"},
+				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
+			},
+			want: []byte(`{"ArnNotLike":{"aws:PrincipalArn":"1"},"StringLike":{"s3:prefix":["one/","two/"]}}`),
+		},
+		// Multiple conditions with duplicated `test` arguments
+		"duplicate condition test single value": {
+			cs: iampolicy.ConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: "one/"},
+				{Test: "StringLike", Variable: "s3:versionid", Values: "abc123"},
+			},
+			want: []byte(`{"StringLike":{"s3:prefix":"one/","s3:versionid":"abc123"}}`),
+		},
+		"duplicate condition test multiple values": {
+			cs: iampolicy.ConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
+				{Test: "StringLike", Variable: "s3:versionid", Values: []string{"abc123", "def456"}},
+			},
+			want: []byte(`{"StringLike":{"s3:prefix":["one/","two/"],"s3:versionid":["abc123","def456"]}}`),
+		},
+		"duplicate condition test mixed value lengths": {
+			cs: iampolicy.ConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: "one/"},
+				{Test: "StringLike", Variable: "s3:versionid", Values: []string{"abc123", "def456"}},
+			},
+			want: []byte(`{"StringLike":{"s3:prefix":"one/","s3:versionid":["abc123","def456"]}}`),
+		},
+		"duplicate condition test mixed value lengths reversed": {
+			cs: iampolicy.ConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
+				{Test: "StringLike", Variable: "s3:versionid", Values: "abc123"},
+			},
+			want: []byte(`{"StringLike":{"s3:prefix":["one/","two/"],"s3:versionid":"abc123"}}`),
+		},
+		// Multiple conditions with duplicated `test` and `variable` arguments
+		"duplicate condition test and variable single value": {
+			cs: iampolicy.ConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: "one/"},
+				{Test: "StringLike", Variable: "s3:prefix", Values: "two/"},
+			},
+			want: []byte(`{"StringLike":{"s3:prefix":["one/","two/"]}}`),
+		},
+		"duplicate condition test and variable multiple values": {
+			cs: iampolicy.ConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
+				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"three/", "four/"}},
+			},
+			want: []byte(`{"StringLike":{"s3:prefix":["one/","two/","three/","four/"]}}`),
+		},
+		"duplicate condition test and variable mixed value lengths": {
+			cs: iampolicy.ConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: "one/"},
+				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"three/", "four/"}},
+			},
+			want: []byte(`{"StringLike":{"s3:prefix":["one/","three/","four/"]}}`),
+		},
+		"duplicate condition test and variable mixed value lengths reversed": {
+			cs: iampolicy.ConditionSet{
+				{Test: "StringLike", Variable: "s3:prefix", Values: []string{"one/", "two/"}},
+				{Test: "StringLike", Variable: "s3:prefix", Values: "three/"},
+			},
+			want: []byte(`{"StringLike":{"s3:prefix":["one/","two/","three/"]}}`),
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tc.cs.MarshalJSON()
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ConditionSet.MarshalJSON() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ConditionSet.MarshalJSON() = %v, want %v", string(got), string(tc.want))
+			}
+		})
+	}
+}
+
+func TestStatementUnmarshalServicePrincipalOrder(t *testing.T) {
+	t.Parallel()
+
+	policy1 := `
+		  {
+			"Action": "sts:AssumeRole",
+			"Principal": {
+			  "Service": ["lambda.amazonaws.com", "service2.amazonaws.com"]
+			},
+			"Effect": "Allow",
+			"Sid": ""
+		  }`
+	// Service order is different, but should be the same object for terraform
+	policy2 := `
+		  {
+			"Action": "sts:AssumeRole",
+			"Principal": {
+			  "Service": ["service2.amazonaws.com", "lambda.amazonaws.com"]
+			},
+			"Effect": "Allow",
+			"Sid": ""
+		  }`
+
+	var data1 iampolicy.Statement
+	var data2 iampolicy.Statement
+	err := json.Unmarshal([]byte(policy1), &data1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = json.Unmarshal([]byte(policy2), &data2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(data1, data2) {
+		t.Fatalf("should be equal, but was:\n%#v\nVS\n%#v\n", data1, data2)
+	}
+}
+
+func TestDocumentMerge(t *testing.T) {
+	t.Parallel()
+
+	doc := &iampolicy.Document{
+		Version: "2008-10-17",
+		Statements: []*iampolicy.Statement{
+			{Sid: "A", Effect: "Allow", Actions: "s3:GetObject"},
+			{Effect: "Allow", Actions: "s3:ListBucket"},
+		},
+	}
+	doc.Merge(&iampolicy.Document{
+		Version: "2012-10-17",
+		Id:      "merged",
+		Statements: []*iampolicy.Statement{
+			{Sid: "A", Effect: "Deny", Actions: "s3:GetObject"},
+			{Effect: "Allow", Actions: "s3:ListBucket"},
+			{Sid: "B", Effect: "Allow", Actions: "s3:PutObject"},
+		},
+	})
+
+	got, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"Version":"2012-10-17","Id":"merged","Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:GetObject"},{"Effect":"Allow","Action":"s3:ListBucket"},{"Effect":"Allow","Action":"s3:ListBucket"},{"Sid":"B","Effect":"Allow","Action":"s3:PutObject"}]}`
+	if string(got) != want {
+		t.Errorf("Merge() = %s, want %s", got, want)
+	}
+}
+
+func TestDocumentUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		policy  string
+		want    string
+		wantErr bool
+	}{
+		"numeric condition": {
+			policy: `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"NumericLessThan":{"aws:MultiFactorAuthAge":3600}}}]}`,
+			want:   `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"NumericLessThan":{"aws:MultiFactorAuthAge":"3600"}}}]}`,
+		},
+		"numeric condition array": {
+			policy: `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"NumericLessThan":{"aws:MultiFactorAuthAge":[3600, 7200]}}}]}`,
+			want:   `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"NumericLessThan":{"aws:MultiFactorAuthAge":["3600","7200"]}}}]}`,
+		},
+		"boolean condition": {
+			policy: `{"Statement":[{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":false}}}]}`,
+			want:   `{"Statement":[{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+		},
+		"boolean condition array": {
+			policy: `{"Statement":[{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":[false]}}}]}`,
+			want:   `{"Statement":[{"Effect":"Deny","Action":"*","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"false"}}}]}`,
+		},
+		"invalid condition value": {
+			policy:  `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"StringLike":{"s3:prefix":[{"a":"b"}]}}}]}`,
+			wantErr: true,
+		},
+		"numeric principal": {
+			policy: `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":123456789012}}]}`,
+			want:   `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"123456789012"}}]}`,
+		},
+		"numeric principal array": {
+			policy: `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":[123456789012]}}]}`,
+			want:   `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":["123456789012"]}}]}`,
+		},
+		"invalid principal value": {
+			policy:  `{"Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":null}}]}`,
+			wantErr: true,
+		},
+		"single statement": {
+			policy: `{"Version":"2012-10-17","Statement":{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}}`,
+			want:   `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+		"no statement": {
+			policy: `{"Version":"2012-10-17"}`,
+			want:   `{"Version":"2012-10-17"}`,
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var doc iampolicy.Document
+			err := json.Unmarshal([]byte(tc.policy), &doc)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Document.UnmarshalJSON() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := json.Marshal(&doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("Document.UnmarshalJSON() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,14 @@
 package json
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
 	smithydocument "github.com/aws/smithy-go/document"
 )
 
@@ -36,3 +44,106 @@ type JSONStringer interface {
 	smithydocument.Marshaler
 	smithydocument.Unmarshaler
 }
+
+// NormalizeSmithyDocument returns the normalized form of a JSON string representing a Smithy document.
+// Object keys are sorted, insignificant whitespace is removed and numbers are written in their shortest
+// exact decimal form, so that e.g. `{"b": 1.0, "a": 1e2}` and `{"a":100,"b":1}` normalize to the same string.
+func NormalizeSmithyDocument(s string) (string, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return "", err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return "", fmt.Errorf("invalid character after top-level value")
+	}
+
+	v, err := normalizeNumbers(v)
+	if err != nil {
+		return "", err
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func normalizeNumbers(v any) (any, error) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			e, err := normalizeNumbers(e)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = e
+		}
+		return v, nil
+	case []any:
+		for i, e := range v {
+			e, err := normalizeNumbers(e)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = e
+		}
+		return v, nil
+	case json.Number:
+		return normalizeNumber(v)
+	default:
+		return v, nil
+	}
+}
+
+// normalizeNumber returns the shortest exact decimal representation of a JSON number.
+func normalizeNumber(n json.Number) (json.Number, error) {
+	s := n.String()
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return "", fmt.Errorf("invalid number: %s", s)
+	}
+
+	if r.IsInt() {
+		return json.Number(r.Num().String()), nil
+	}
+
+	// The value of mantissa*10^exponent has at most len(fraction)-exponent decimal places.
+	mantissa, exponent, _ := strings.Cut(strings.ToLower(s), "e")
+	places := 0
+	if _, fraction, ok := strings.Cut(mantissa, "."); ok {
+		places = len(fraction)
+	}
+	if exponent != "" {
+		e, err := strconv.Atoi(exponent)
+		if err != nil {
+			return "", fmt.Errorf("invalid number: %s", s)
+		}
+		places -= e
+	}
+
+	return json.Number(strings.TrimRight(r.FloatString(places), "0")), nil
+}
+
+// SmithyDocumentsEquivalent returns whether two JSON strings represent the same Smithy document.
+func SmithyDocumentsEquivalent(s1, s2 string) (bool, error) {
+	n1, err := NormalizeSmithyDocument(s1)
+	if err != nil {
+		return false, err
+	}
+
+	n2, err := NormalizeSmithyDocument(s2)
+	if err != nil {
+		return false, err
+	}
+
+	return n1 == n2, nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package json_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+func TestNormalizeSmithyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName  string
+		input     string
+		want      string
+		wantError bool
+	}{
+		{
+			testName:  "invalid JSON",
+			input:     `test`,
+			wantError: true,
+		},
+		{
+			testName:  "trailing data",
+			input:     `{"A": 1} }`,
+			wantError: true,
+		},
+		{
+			testName: "empty object",
+			input:    ` { } `,
+			want:     `{}`,
+		},
+		{
+			testName: "key order and whitespace",
+			input: `{
+				"B": {"D": true, "C": null},
+				"A": ["x", "y"]
+			}`,
+			want: `{"A":["x","y"],"B":{"C":null,"D":true}}`,
+		},
+		{
+			testName: "integers",
+			input:    `[1, 1.0, 1.000, 1e2, 1E+2, 100e-2, -0, -0.0, 12345678901234567890]`,
+			want:     `[1,1,1,100,100,1,0,0,12345678901234567890]`,
+		},
+		{
+			testName: "decimals",
+			input:    `[1.50, 15e-1, 0.125, 125e-3, -2.50e1, 1.5e-10]`,
+			want:     `[1.5,1.5,0.125,0.125,-25,0.00000000015]`,
+		},
+		{
+			testName: "scalar document",
+			input:    `"<a&b>"`,
+			want:     `"<a&b>"`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := json.NormalizeSmithyDocument(testCase.input)
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Errorf("NormalizeSmithyDocument(%s) err %t, want %t", testCase.input, got, want)
+			}
+			if err == nil {
+				if diff := cmp.Diff(got, testCase.want); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSmithyDocumentsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName       string
+		x, y           string
+		wantEquivalent bool
+	}{
+		{
+			testName: "invalid JSON first",
+			x:        `test`,
+			y:        `{}`,
+		},
+		{
+			testName: "invalid JSON second",
+			x:        `{}`,
+			y:        `test`,
+		},
+		{
+			testName:       "equivalent",
+			x:              `{"A": 1.0, "B": {"D": [1e1, "x"], "C": false}}`,
+			y:              `{"B":{"C":false,"D":[10,"x"]},"A":1}`,
+			wantEquivalent: true,
+		},
+		{
+			testName: "array order",
+			x:        `[1, 2]`,
+			y:        `[2, 1]`,
+		},
+		{
+			testName: "number and string",
+			x:        `{"A": 1}`,
+			y:        `{"A": "1"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			equivalent, _ := json.SmithyDocumentsEquivalent(testCase.x, testCase.y)
+			if got, want := equivalent, testCase.wantEquivalent; !cmp.Equal(got, want) {
+				t.Errorf("SmithyDocumentsEquivalent(%s, %s) = %t, want %t", testCase.x, testCase.y, got, want)
+			}
+		})
+	}
+}
@@ -13,10 +13,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -521,9 +523,35 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
+	type planModifier interface {
+		modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, when)
+	}
+
+	for _, v := range w.interceptors {
+		if v, ok := v.(planModifier); ok {
+			v.modifyPlan(ctx, request, response, w.meta, Before)
+		}
+	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Interceptors that check the plan run after the resource's ModifyPlan.
+	for _, v := range w.interceptors {
+		if v, ok := v.(planModifier); ok {
+			v.modifyPlan(ctx, request, response, w.meta, After)
+		}
 	}
 }
 
@@ -583,6 +611,9 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 		return ctx, diags
 	}
 
+	// The resource may opt out of some or all provider configured default_tags.
+	tagsInContext.DefaultConfig = resourceDefaultTagsConfig(ctx, tagsInContext.DefaultConfig, request.Plan.GetAttribute)
+
 	switch when {
 	case Before:
 		var planTags tftags.Map
@@ -613,6 +644,57 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 	return ctx, diags
 }
 
+// modifyPlan applies the resource's opt out of provider configured default_tags before the resource's ModifyPlan
+// and checks the planned tags against any provider configured tag policy after.
+func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, when when) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if r.tags == nil || meta == nil || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return
+	}
+
+	if when == Before {
+		tagsInContext.DefaultConfig = resourceDefaultTagsConfig(ctx, tagsInContext.DefaultConfig, request.Plan.GetAttribute)
+
+		return
+	}
+
+	policy := meta.TagPolicy(ctx)
+	if !policy.AppliesTo(inContext.TypeName) {
+		return
+	}
+
+	var planTags tftags.Map
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if planTags.IsUnknown() {
+		return
+	}
+	for _, v := range planTags.Elements() {
+		if v.IsUnknown() {
+			return
+		}
+	}
+
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags))
+
+	for _, v := range policy.Check(inContext.TypeName, tags) {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrTags).AtMapKey(v.Key), "Tag policy violation", v.Error())
+	}
+}
+
 func (r tagsResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	if r.tags == nil {
 		return ctx, diags
@@ -650,6 +732,9 @@ func (r tagsResourceInterceptor) read(ctx context.Context, request resource.Read
 			return ctx, diags
 		}
 
+		// The resource may opt out of some or all provider configured default_tags.
+		tagsInContext.DefaultConfig = resourceDefaultTagsConfig(ctx, tagsInContext.DefaultConfig, response.State.GetAttribute)
+
 		// If the R handler didn't set tags, try and read them from the service API.
 		if tagsInContext.TagsOut.IsNone() {
 			if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
@@ -756,6 +841,9 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 		return ctx, diags
 	}
 
+	// The resource may opt out of some or all provider configured default_tags.
+	tagsInContext.DefaultConfig = resourceDefaultTagsConfig(ctx, tagsInContext.DefaultConfig, request.Plan.GetAttribute)
+
 	switch when {
 	case Before:
 		var planTags tftags.Map
@@ -832,6 +920,19 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 	return ctx, diags
 }
 
+// resourceDefaultTagsConfig returns the provider configured default_tags that apply to a resource,
+// honoring any `skip_default_tags` and `default_tags_exclude` attributes defined in its schema.
+func resourceDefaultTagsConfig(ctx context.Context, defaultConfig *tftags.DefaultConfig, getAttribute func(context.Context, path.Path, any) diag.Diagnostics) *tftags.DefaultConfig {
+	// Diagnostics are returned for attributes that are not defined in the resource's schema and the values are left null.
+	var skip basetypes.BoolValue
+	getAttribute(ctx, path.Root(names.AttrSkipDefaultTags), &skip)
+
+	var excludeKeys fwtypes.SetOfString
+	getAttribute(ctx, path.Root(names.AttrDefaultTagsExclude), &excludeKeys)
+
+	return defaultConfig.ForResource(skip.ValueBool(), flex.ExpandFrameworkStringValueSet(ctx, excludeKeys))
+}
+
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -72,6 +74,13 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "How service endpoints are resolved. Valid values are `default` and `mock`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(conns.EndpointMode_Values()...),
+				},
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -92,6 +101,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
 			},
+			"mock_endpoint_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the local endpoint to which all API calls are sent when `endpoint_mode` is `mock`.",
+			},
 			"no_proxy": schema.StringAttribute{
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
@@ -170,6 +183,35 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"api_rate_limits": schema.ListNestedBlock{
+				Description: "Configuration blocks with settings to rate limit API calls, by service and optionally by operation.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"burst": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of API calls that can be made at once. Defaults to `requests_per_second`, rounded up.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"operation": schema.StringAttribute{
+							Optional:    true,
+							Description: "The name of the API operation to rate limit, for example `ListAccounts`. If not set, the limit applies to all of the service's operations that don't have their own limit.",
+						},
+						"requests_per_second": schema.Float64Attribute{
+							Required:    true,
+							Description: "The sustained rate, in API calls per second, at which the API operations can be called.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service whose API calls are rate limited, named as in the `endpoints` block, for example `organizations`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(names.ProviderPackages()...),
+							},
+						},
+					},
+				},
+			},
 			"assume_role": schema.ListNestedBlock{
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
@@ -292,6 +334,63 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to enforce rules on resource tags at plan time.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"organizations_policy": schema.StringAttribute{
+							Optional:    true,
+							Description: "An AWS Organizations tag policy JSON document whose tag key capitalization and allowed values are enforced.",
+						},
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types, for example `aws_s3_bucket`, to which the tag policy applies. If not set, the tag policy applies to all resources that support tags.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"tag": schema.ListNestedBlock{
+							Description: "Configuration blocks with the rules for a tag key.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_value_patterns": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Regular expressions, one of which the tag value must match if `allowed_values` doesn't contain the value.",
+									},
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Values that the tag can have.",
+									},
+									"enforce_key_case": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether the tag key must match the case of `key`. Otherwise keys match case-insensitively.",
+									},
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "The tag key.",
+									},
+									"required": schema.BoolAttribute{
+										Optional:    true,
+										Description: "Whether the tag must be present.",
+									},
+									"value_case": schema.StringAttribute{
+										Optional:    true,
+										Description: "The case that the tag value must have. Valid values are `lower` and `upper`.",
+										Validators: []validator.String{
+											stringvalidator.OneOf(tftags.TagPolicyValueCase_Values()...),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -408,7 +507,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if meta != nil {
 					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx), meta.IgnoreTagsConfig(ctx))
 					ctx = meta.RegisterLogger(ctx)
@@ -522,7 +621,15 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
+		tffunction.NewARNMatchFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewCIDRSubnetsByAZFunction,
+		tffunction.NewDNSSuffixFunction,
+		tffunction.NewIAMPolicyEqualFunction,
+		tffunction.NewIAMPolicyMergeFunction,
+		tffunction.NewIAMPolicyNormalizeFunction,
+		tffunction.NewScheduleExpressionNextFunction,
+		tffunction.NewServicePrincipalFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
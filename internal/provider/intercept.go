@@ -234,6 +234,9 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 		return ctx, diags
 	}
 
+	// The resource may opt out of some or all provider configured default_tags.
+	tagsInContext.DefaultConfig = tftags.ResourceDefaultConfig(tagsInContext.DefaultConfig, d)
+
 	switch when {
 	case Before:
 		switch why {
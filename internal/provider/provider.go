@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -52,6 +53,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_rate_limits":               apiRateLimitsSchema(),
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -90,6 +92,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Protocol to use with EC2 metadata service endpoint." +
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "How service endpoints are resolved. Valid values are `default` and `mock`.",
+				ValidateFunc: validation.StringInSlice(conns.EndpointMode_Values(), false),
+			},
 			"endpoints": endpointsSchema(),
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
@@ -146,6 +154,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"mock_endpoint_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "URL of the local endpoint to which all API calls are sent when `endpoint_mode` is `mock`.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
 			"no_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -234,6 +248,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": tagPolicySchema(),
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -369,7 +384,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
 					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx), v.IgnoreTagsConfig(ctx))
 					ctx = v.RegisterLogger(ctx)
@@ -484,9 +499,11 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		EndpointMode:                   d.Get("endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
+		MockEndpointURL:                d.Get("mock_endpoint_url").(string),
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
@@ -518,6 +535,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("api_rate_limits"); ok && len(v.([]any)) > 0 {
+		config.APIRateLimits = expandAPIRateLimits(v.([]any))
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok {
 		path := cty.GetAttrPath("assume_role")
 		v := v.([]any)
@@ -598,6 +619,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, nil)
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tagPolicy, err := expandTagPolicy(v.([]any)[0].(map[string]any))
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+		config.TagPolicy = tagPolicy
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -634,6 +663,107 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	return meta, diags
 }
 
+func apiRateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Configuration blocks with settings to rate limit API calls, by service and optionally by operation.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of API calls that can be made at once. Defaults to `requests_per_second`, rounded up.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"operation": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The name of the API operation to rate limit, for example `ListAccounts`. If not set, the limit applies to all of the service's operations that don't have their own limit.",
+				},
+				"requests_per_second": {
+					Type:        schema.TypeFloat,
+					Required:    true,
+					Description: "The sustained rate, in API calls per second, at which the API operations can be called.",
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service whose API calls are rate limited, named as in the `endpoints` block, for example `organizations`.",
+					ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+				},
+			},
+		},
+	}
+}
+
+func tagPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to enforce rules on resource tags at plan time.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"organizations_policy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "An AWS Organizations tag policy JSON document whose tag key capitalization and allowed values are enforced.",
+					ValidateFunc: validation.StringIsJSON,
+				},
+				"resource_types": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Resource types, for example `aws_s3_bucket`, to which the tag policy applies. If not set, the tag policy applies to all resources that support tags.",
+				},
+				"tag": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Configuration blocks with the rules for a tag key.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"allowed_value_patterns": {
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+								Description: "Regular expressions, one of which the tag value must match if `allowed_values` doesn't contain the value.",
+							},
+							"allowed_values": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Values that the tag can have.",
+							},
+							"enforce_key_case": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Whether the tag key must match the case of `key`. Otherwise keys match case-insensitively.",
+							},
+							names.AttrKey: {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "The tag key.",
+							},
+							"required": {
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Description: "Whether the tag must be present.",
+							},
+							"value_case": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Description:  "The case that the tag value must have. Valid values are `lower` and `upper`.",
+								ValidateFunc: validation.StringInSlice(tftags.TagPolicyValueCase_Values(), false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -870,6 +1000,100 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interfa
 	return &assumeRole
 }
 
+func expandAPIRateLimits(tfList []any) []conns.APIRateLimit {
+	var apiObjects []conns.APIRateLimit
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := conns.APIRateLimit{}
+
+		if v, ok := tfMap["burst"].(int); ok {
+			apiObject.Burst = v
+		}
+
+		if v, ok := tfMap["operation"].(string); ok {
+			apiObject.Operation = v
+		}
+
+		if v, ok := tfMap["requests_per_second"].(float64); ok {
+			apiObject.RequestsPerSecond = v
+		}
+
+		if v, ok := tfMap["service"].(string); ok {
+			apiObject.Service = v
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTagPolicy(tfMap map[string]any) (*tftags.TagPolicy, error) {
+	tagPolicy := &tftags.TagPolicy{}
+
+	if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		tagPolicy.ResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["organizations_policy"].(string); ok && v != "" {
+		rules, err := tftags.ParseOrganizationsTagPolicy(v)
+		if err != nil {
+			return nil, err
+		}
+		tagPolicy.Rules = append(tagPolicy.Rules, rules...)
+	}
+
+	if v, ok := tfMap["tag"].([]any); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			rule := tftags.TagPolicyRule{}
+
+			if v, ok := tfMap["allowed_value_patterns"].([]any); ok {
+				for _, v := range flex.ExpandStringValueList(v) {
+					re, err := regexp.Compile(v)
+					if err != nil {
+						return nil, fmt.Errorf("tag policy allowed value pattern (%s): %w", v, err)
+					}
+					rule.AllowedValuePatterns = append(rule.AllowedValuePatterns, re)
+				}
+			}
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+				rule.AllowedValues = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["enforce_key_case"].(bool); ok {
+				rule.EnforceKeyCase = v
+			}
+
+			if v, ok := tfMap[names.AttrKey].(string); ok {
+				rule.Key = v
+			}
+
+			if v, ok := tfMap["required"].(bool); ok {
+				rule.Required = v
+			}
+
+			if v, ok := tfMap["value_case"].(string); ok {
+				rule.ValueCase = v
+			}
+
+			tagPolicy.Rules = append(tagPolicy.Rules, rule)
+		}
+	}
+
+	return tagPolicy, nil
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftags.DefaultConfig {
 	tags := make(map[string]interface{})
 	for _, ev := range os.Environ() {
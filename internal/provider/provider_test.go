@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -422,6 +423,62 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandAPIRateLimits(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfList   []any
+		expected []conns.APIRateLimit
+	}{
+		"empty": {},
+		"service": {
+			tfList: []any{
+				map[string]any{
+					"burst":               0,
+					"operation":           "",
+					"requests_per_second": 2.0,
+					"service":             names.Organizations,
+				},
+			},
+			expected: []conns.APIRateLimit{
+				{Service: names.Organizations, RequestsPerSecond: 2},
+			},
+		},
+		"service and operation": {
+			tfList: []any{
+				map[string]any{
+					"burst":               0,
+					"operation":           "",
+					"requests_per_second": 10.0,
+					"service":             names.Route53,
+				},
+				map[string]any{
+					"burst":               1,
+					"operation":           "ChangeResourceRecordSets",
+					"requests_per_second": 0.5,
+					"service":             names.Route53,
+				},
+			},
+			expected: []conns.APIRateLimit{
+				{Service: names.Route53, RequestsPerSecond: 10},
+				{Service: names.Route53, Operation: "ChangeResourceRecordSets", RequestsPerSecond: 0.5, Burst: 1},
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results := expandAPIRateLimits(testcase.tfList)
+
+			if diff := cmp.Diff(testcase.expected, results); diff != "" {
+				t.Errorf("Unexpected api_rate_limits diff: %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
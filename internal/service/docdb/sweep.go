@@ -24,12 +24,12 @@ func RegisterSweepers() {
 		"aws_docdb_cluster_instance",
 	)
 
-	resource.AddTestSweepers("aws_docdb_cluster_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_instance", &resource.Sweeper{
 		Name: "aws_docdb_cluster_instance",
 		F:    sweepClusterInstances,
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_parameter_group", &resource.Sweeper{
 		Name: "aws_docdb_cluster_parameter_group",
 		F:    sweepClusterParameterGroups,
 		Dependencies: []string{
@@ -37,7 +37,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_cluster_snapshot", &resource.Sweeper{
 		Name: "aws_docdb_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 		Dependencies: []string{
@@ -45,12 +45,12 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_event_subscription", &resource.Sweeper{
 		Name: "aws_docdb_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_docdb_global_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_global_cluster", &resource.Sweeper{
 		Name: "aws_docdb_global_cluster",
 		F:    sweepGlobalClusters,
 		Dependencies: []string{
@@ -58,7 +58,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_docdb_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_docdb_subnet_group", &resource.Sweeper{
 		Name: "aws_docdb_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -101,7 +101,10 @@ func sweepClusters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepa
 				d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
 			}
 
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.Describe(sweep.NewSweepResource(r, d, client), func(info *sweep.ResourceInfo) {
+				info.Name = id
+				info.CreatedAt = v.ClusterCreateTime
+			}))
 		}
 	}
 
@@ -195,6 +195,7 @@ func (r *securityGroupRuleResource) Schema(ctx context.Context, request resource
 					fwvalidators.IPv6CIDRNetworkAddress(),
 				},
 			},
+			names.AttrDefaultTagsExclude: tftags.DefaultTagsExcludeAttribute(),
 			names.AttrDescription: schema.StringAttribute{
 				Optional: true,
 			},
@@ -227,8 +228,9 @@ func (r *securityGroupRuleResource) Schema(ctx context.Context, request resource
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			names.AttrTags:    tftags.TagsAttribute(),
-			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrSkipDefaultTags: tftags.SkipDefaultTagsAttribute(),
+			names.AttrTags:            tftags.TagsAttribute(),
+			names.AttrTagsAll:         tftags.TagsAttributeComputedOnly(),
 			"to_port": schema.Int64Attribute{
 				Optional: true,
 				Validators: []validator.Int64{
@@ -431,20 +433,22 @@ func flattenReferencedSecurityGroup(ctx context.Context, apiObject *awstypes.Ref
 }
 
 type securityGroupRuleResourceModel struct {
-	ARN                       types.String `tfsdk:"arn"`
-	CIDRIPv4                  types.String `tfsdk:"cidr_ipv4"`
-	CIDRIPv6                  types.String `tfsdk:"cidr_ipv6"`
-	Description               types.String `tfsdk:"description"`
-	FromPort                  types.Int64  `tfsdk:"from_port"`
-	ID                        types.String `tfsdk:"id"`
-	IPProtocol                ipProtocol   `tfsdk:"ip_protocol"`
-	PrefixListID              types.String `tfsdk:"prefix_list_id"`
-	ReferencedSecurityGroupID types.String `tfsdk:"referenced_security_group_id"`
-	SecurityGroupID           types.String `tfsdk:"security_group_id"`
-	SecurityGroupRuleID       types.String `tfsdk:"security_group_rule_id"`
-	Tags                      tftags.Map   `tfsdk:"tags"`
-	TagsAll                   tftags.Map   `tfsdk:"tags_all"`
-	ToPort                    types.Int64  `tfsdk:"to_port"`
+	ARN                       types.String        `tfsdk:"arn"`
+	CIDRIPv4                  types.String        `tfsdk:"cidr_ipv4"`
+	CIDRIPv6                  types.String        `tfsdk:"cidr_ipv6"`
+	DefaultTagsExclude        fwtypes.SetOfString `tfsdk:"default_tags_exclude"`
+	Description               types.String        `tfsdk:"description"`
+	FromPort                  types.Int64         `tfsdk:"from_port"`
+	ID                        types.String        `tfsdk:"id"`
+	IPProtocol                ipProtocol          `tfsdk:"ip_protocol"`
+	PrefixListID              types.String        `tfsdk:"prefix_list_id"`
+	ReferencedSecurityGroupID types.String        `tfsdk:"referenced_security_group_id"`
+	SecurityGroupID           types.String        `tfsdk:"security_group_id"`
+	SecurityGroupRuleID       types.String        `tfsdk:"security_group_rule_id"`
+	SkipDefaultTags           types.Bool          `tfsdk:"skip_default_tags"`
+	Tags                      tftags.Map          `tfsdk:"tags"`
+	TagsAll                   tftags.Map          `tfsdk:"tags_all"`
+	ToPort                    types.Int64         `tfsdk:"to_port"`
 }
 
 func (model *securityGroupRuleResourceModel) InitFromID() error {
@@ -5,7 +5,6 @@ package ecr
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -14,7 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -68,17 +66,10 @@ func dataSourceAuthorizationTokenRead(ctx context.Context, d *schema.ResourceDat
 	authorizationToken := aws.ToString(authorizationData.AuthorizationToken)
 	expiresAt := aws.ToTime(authorizationData.ExpiresAt).Format(time.RFC3339)
 	proxyEndpoint := aws.ToString(authorizationData.ProxyEndpoint)
-	authBytes, err := itypes.Base64Decode(authorizationToken)
+	userName, password, err := DecodeAuthorizationToken(authorizationToken)
 	if err != nil {
-		d.SetId("")
-		return sdkdiag.AppendErrorf(diags, "decoding ECR authorization token: %s", err)
+		return sdkdiag.AppendFromErr(diags, err)
 	}
-	basicAuthorization := strings.Split(string(authBytes), ":")
-	if len(basicAuthorization) != 2 {
-		return sdkdiag.AppendErrorf(diags, "unknown ECR authorization token format")
-	}
-	userName := basicAuthorization[0]
-	password := basicAuthorization[1]
 	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set("authorization_token", authorizationToken)
 	d.Set("proxy_endpoint", proxyEndpoint)
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_ecr_authorization_token", name="Authorization Token")
+func newEphemeralAuthorizationToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAuthorizationToken{}, nil
+}
+
+const (
+	ResNameAuthorizationToken = "Authorization Token"
+)
+
+type ephemeralAuthorizationToken struct {
+	framework.EphemeralResourceWithConfigure
+	framework.WithRenewBeforeExpiry
+}
+
+func (e *ephemeralAuthorizationToken) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_ecr_authorization_token"
+}
+
+func (e *ephemeralAuthorizationToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"proxy_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			"proxy_endpoints": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Computed:   true,
+			},
+			"registry_ids": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Optional:   true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAuthorizationToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAuthorizationTokenData
+	conn := e.Meta().ECRClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	registryIDs := fwflex.ExpandFrameworkStringValueSet(ctx, data.RegistryIDs)
+	input := &ecr.GetAuthorizationTokenInput{}
+	if len(registryIDs) > 0 {
+		// The authorization token can be used to access any registry that the IAM principal has access to,
+		// but the API still returns the proxy endpoint of each requested registry.
+		input.RegistryIds = registryIDs //nolint:staticcheck // deprecated by AWS, but the only source of per-registry proxy endpoints
+	}
+
+	output, err := conn.GetAuthorizationToken(ctx, input)
+	if err == nil && (output == nil || len(output.AuthorizationData) == 0) {
+		err = fmt.Errorf("empty result")
+	}
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ECR, create.ErrActionOpening, ResNameAuthorizationToken, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	authorizationData := output.AuthorizationData[0]
+	authorizationToken := aws.ToString(authorizationData.AuthorizationToken)
+	userName, password, err := DecodeAuthorizationToken(authorizationToken)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ECR, create.ErrActionOpening, ResNameAuthorizationToken, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	proxyEndpoint := aws.ToString(authorizationData.ProxyEndpoint)
+	proxyEndpoints := make(map[string]attr.Value)
+	if len(registryIDs) > 0 {
+		for _, v := range output.AuthorizationData {
+			registryID, err := proxyEndpointRegistryID(aws.ToString(v.ProxyEndpoint))
+			if err != nil {
+				response.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.ECR, create.ErrActionOpening, ResNameAuthorizationToken, "", err),
+					err.Error(),
+				)
+				return
+			}
+			proxyEndpoints[registryID] = types.StringValue(aws.ToString(v.ProxyEndpoint))
+		}
+
+		for _, registryID := range registryIDs {
+			if _, ok := proxyEndpoints[registryID]; !ok {
+				err := fmt.Errorf("no authorization data returned")
+				response.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.ECR, create.ErrActionOpening, ResNameAuthorizationToken, registryID, err),
+					err.Error(),
+				)
+				return
+			}
+		}
+
+		// The proxy endpoint of the caller's default registry is only returned if no registries are specified.
+		proxyEndpoint, err = registryProxyEndpoint(proxyEndpoint, e.Meta().AccountID(ctx))
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.ECR, create.ErrActionOpening, ResNameAuthorizationToken, "", err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	data.AuthorizationToken = types.StringValue(authorizationToken)
+	data.ExpiresAt = fwflex.TimeToFramework(ctx, authorizationData.ExpiresAt)
+	data.Password = types.StringValue(password)
+	data.ProxyEndpoint = types.StringValue(proxyEndpoint)
+	data.ProxyEndpoints = fwtypes.NewMapValueOfMust[types.String](ctx, proxyEndpoints)
+	data.UserName = types.StringValue(userName)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	renewAt, diags := e.SetExpiry(ctx, response.Private, aws.ToTime(authorizationData.ExpiresAt))
+	response.Diagnostics.Append(diags...)
+	response.RenewAt = renewAt
+}
+
+// DecodeAuthorizationToken returns the user name and password encoded in an ECR or ECR Public authorization token.
+func DecodeAuthorizationToken(authorizationToken string) (string, string, error) {
+	v, err := itypes.Base64Decode(authorizationToken)
+	if err != nil {
+		return "", "", fmt.Errorf("decoding ECR authorization token: %w", err)
+	}
+
+	userName, password, ok := strings.Cut(string(v), ":")
+	if !ok {
+		return "", "", fmt.Errorf("unknown ECR authorization token format")
+	}
+
+	return userName, password, nil
+}
+
+// proxyEndpointRegistryID returns the ID of the registry with the specified proxy endpoint.
+// Registry host names have the form <registry ID>.dkr.ecr.<region>.<DNS suffix>.
+func proxyEndpointRegistryID(proxyEndpoint string) (string, error) {
+	u, err := url.Parse(proxyEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	registryID, _, ok := strings.Cut(u.Host, ".")
+	if !ok {
+		return "", fmt.Errorf("unexpected ECR proxy endpoint format: %s", proxyEndpoint)
+	}
+
+	return registryID, nil
+}
+
+// registryProxyEndpoint returns the proxy endpoint of the specified registry,
+// given the proxy endpoint of the caller's default registry.
+// Registry host names have the form <registry ID>.dkr.ecr.<region>.<DNS suffix>.
+func registryProxyEndpoint(proxyEndpoint, registryID string) (string, error) {
+	u, err := url.Parse(proxyEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	_, domain, ok := strings.Cut(u.Host, ".")
+	if !ok {
+		return "", fmt.Errorf("unexpected ECR proxy endpoint format: %s", proxyEndpoint)
+	}
+	u.Host = registryID + "." + domain
+
+	return u.String(), nil
+}
+
+type epAuthorizationTokenData struct {
+	AuthorizationToken types.String        `tfsdk:"authorization_token"`
+	ExpiresAt          timetypes.RFC3339   `tfsdk:"expires_at"`
+	Password           types.String        `tfsdk:"password"`
+	ProxyEndpoint      types.String        `tfsdk:"proxy_endpoint"`
+	ProxyEndpoints     fwtypes.MapOfString `tfsdk:"proxy_endpoints"`
+	RegistryIDs        fwtypes.SetOfString `tfsdk:"registry_ids"`
+	UserName           types.String        `tfsdk:"user_name"`
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecr_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfecr "github.com/hashicorp/terraform-provider-aws/internal/service/ecr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDecodeAuthorizationToken(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		authorizationToken string
+		expectedUserName   string
+		expectedPassword   string
+		expectError        bool
+	}{
+		{
+			name:               "valid",
+			authorizationToken: "QVdTOnNlY3JldA==", // AWS:secret
+			expectedUserName:   "AWS",
+			expectedPassword:   "secret",
+		},
+		{
+			name:               "invalid encoding",
+			authorizationToken: "not base64!",
+			expectError:        true,
+		},
+		{
+			name:               "no separator",
+			authorizationToken: "QVdT", // AWS
+			expectError:        true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			userName, password, err := tfecr.DecodeAuthorizationToken(testCase.authorizationToken)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error: %t", err, want)
+			}
+
+			if got, want := userName, testCase.expectedUserName; got != want {
+				t.Errorf("user name got: %s, expected: %s", got, want)
+			}
+			if got, want := password, testCase.expectedPassword; got != want {
+				t.Errorf("password got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestProxyEndpointRegistryID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		proxyEndpoint string
+		expected      string
+		expectError   bool
+	}{
+		{
+			name:          "standard",
+			proxyEndpoint: "https://123456789012.dkr.ecr.us-west-2.amazonaws.com",
+			expected:      "123456789012",
+		},
+		{
+			name:          "China",
+			proxyEndpoint: "https://123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn",
+			expected:      "123456789012",
+		},
+		{
+			name:          "invalid",
+			proxyEndpoint: "https://localhost",
+			expectError:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfecr.ProxyEndpointRegistryID(testCase.proxyEndpoint)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error: %t", err, want)
+			}
+
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestRegistryProxyEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		proxyEndpoint string
+		registryID    string
+		expected      string
+		expectError   bool
+	}{
+		{
+			name:          "standard",
+			proxyEndpoint: "https://123456789012.dkr.ecr.us-west-2.amazonaws.com",
+			registryID:    "210987654321",
+			expected:      "https://210987654321.dkr.ecr.us-west-2.amazonaws.com",
+		},
+		{
+			name:          "China",
+			proxyEndpoint: "https://123456789012.dkr.ecr.cn-north-1.amazonaws.com.cn",
+			registryID:    "210987654321",
+			expected:      "https://210987654321.dkr.ecr.cn-north-1.amazonaws.com.cn",
+		},
+		{
+			name:          "invalid",
+			proxyEndpoint: "https://localhost",
+			registryID:    "210987654321",
+			expectError:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfecr.RegistryProxyEndpoint(testCase.proxyEndpoint, testCase.registryID)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error: %t", err, want)
+			}
+
+			if got, want := got, testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAccECRAuthorizationTokenEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dp := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.ECRServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthorizationTokenEphemeralConfig_basic(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey("authorization_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey("expires_at"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey(names.AttrPassword), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey("proxy_endpoint"), knownvalue.StringRegexp(regexache.MustCompile(`^https://\d{12}\.dkr\.ecr\.`))),
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey("proxy_endpoints"), knownvalue.MapSizeExact(1)),
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey(names.AttrUserName), knownvalue.StringExact("AWS")),
+				},
+			},
+		},
+	})
+}
+
+func testAccAuthorizationTokenEphemeralConfig_basic() string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_ecr_authorization_token.test"),
+		`
+data "aws_caller_identity" "current" {}
+
+ephemeral "aws_ecr_authorization_token" "test" {
+  registry_ids = [data.aws_caller_identity.current.account_id]
+}
+`)
+}
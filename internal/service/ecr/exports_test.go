@@ -23,4 +23,6 @@ var (
 	FindRepositoryByName                             = findRepositoryByName
 	FindRepositoryCreationTemplateByRepositoryPrefix = findRepositoryCreationTemplateByRepositoryPrefix
 	FindRepositoryPolicyByRepositoryName             = findRepositoryPolicyByRepositoryName
+	ProxyEndpointRegistryID                          = proxyEndpointRegistryID
+	RegistryProxyEndpoint                            = registryProxyEndpoint
 )
@@ -5,7 +5,6 @@ package ecrpublic
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -14,7 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
-	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	tfecr "github.com/hashicorp/terraform-provider-aws/internal/service/ecr"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -60,19 +59,12 @@ func dataSourceAuthorizationTokenRead(ctx context.Context, d *schema.ResourceDat
 	authorizationData := out.AuthorizationData
 	authorizationToken := aws.ToString(authorizationData.AuthorizationToken)
 	expiresAt := aws.ToTime(authorizationData.ExpiresAt).Format(time.RFC3339)
-	authBytes, err := itypes.Base64Decode(authorizationToken)
+	userName, password, err := tfecr.DecodeAuthorizationToken(authorizationToken)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "decoding ECR Public authorization token: %s", err)
+		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	basicAuthorization := strings.Split(string(authBytes), ":")
-	if len(basicAuthorization) != 2 {
-		return sdkdiag.AppendErrorf(diags, "unknown ECR Public authorization token format")
-	}
-
-	userName := basicAuthorization[0]
-	password := basicAuthorization[1]
 	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set("authorization_token", authorizationToken)
 	d.Set("expires_at", expiresAt)
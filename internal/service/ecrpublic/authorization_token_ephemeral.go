@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecrpublic
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecrpublic"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfecr "github.com/hashicorp/terraform-provider-aws/internal/service/ecr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_ecrpublic_authorization_token", name="Authorization Token")
+func newEphemeralAuthorizationToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAuthorizationToken{}, nil
+}
+
+const (
+	ResNameAuthorizationToken = "Authorization Token"
+
+	// publicRegistryProxyEndpoint is the proxy endpoint of all ECR Public registries.
+	publicRegistryProxyEndpoint = "https://public.ecr.aws"
+)
+
+type ephemeralAuthorizationToken struct {
+	framework.EphemeralResourceWithConfigure
+	framework.WithRenewBeforeExpiry
+}
+
+func (e *ephemeralAuthorizationToken) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_ecrpublic_authorization_token"
+}
+
+func (e *ephemeralAuthorizationToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrPassword: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"proxy_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAuthorizationToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAuthorizationTokenData
+	conn := e.Meta().ECRPublicClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.GetAuthorizationToken(ctx, &ecrpublic.GetAuthorizationTokenInput{})
+	if err == nil && (output == nil || output.AuthorizationData == nil) {
+		err = fmt.Errorf("empty result")
+	}
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ECRPublic, create.ErrActionOpening, ResNameAuthorizationToken, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	authorizationData := output.AuthorizationData
+	authorizationToken := aws.ToString(authorizationData.AuthorizationToken)
+	userName, password, err := tfecr.DecodeAuthorizationToken(authorizationToken)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ECRPublic, create.ErrActionOpening, ResNameAuthorizationToken, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.AuthorizationToken = types.StringValue(authorizationToken)
+	data.ExpiresAt = fwflex.TimeToFramework(ctx, authorizationData.ExpiresAt)
+	data.Password = types.StringValue(password)
+	data.ProxyEndpoint = types.StringValue(publicRegistryProxyEndpoint)
+	data.UserName = types.StringValue(userName)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	renewAt, diags := e.SetExpiry(ctx, response.Private, aws.ToTime(authorizationData.ExpiresAt))
+	response.Diagnostics.Append(diags...)
+	response.RenewAt = renewAt
+}
+
+type epAuthorizationTokenData struct {
+	AuthorizationToken types.String      `tfsdk:"authorization_token"`
+	ExpiresAt          timetypes.RFC3339 `tfsdk:"expires_at"`
+	Password           types.String      `tfsdk:"password"`
+	ProxyEndpoint      types.String      `tfsdk:"proxy_endpoint"`
+	UserName           types.String      `tfsdk:"user_name"`
+}
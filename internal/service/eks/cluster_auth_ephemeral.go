@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_eks_cluster_auth", name="Cluster Auth")
+func newEphemeralClusterAuth(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralClusterAuth{}, nil
+}
+
+const (
+	ResNameClusterAuth = "Cluster Auth"
+)
+
+type ephemeralClusterAuth struct {
+	framework.EphemeralResourceWithConfigure
+	framework.WithRenewBeforeExpiry
+}
+
+func (e *ephemeralClusterAuth) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_eks_cluster_auth"
+}
+
+func (e *ephemeralClusterAuth) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralClusterAuth) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epClusterAuthData
+	conn := e.Meta().STSClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if roleARN := data.RoleARN.ValueString(); roleARN != "" {
+		credentials := aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(conn, roleARN))
+		conn = sts.New(conn.Options(), func(o *sts.Options) {
+			o.Credentials = credentials
+		})
+	}
+
+	name := data.Name.ValueString()
+	generator, err := NewGenerator(false, false)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EKS, create.ErrActionOpening, ResNameClusterAuth, name, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The token's validity period starts when the request is signed.
+	expiration := time.Now().Add(presignedURLExpiration)
+	token, err := generator.GetWithSTS(ctx, name, conn)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EKS, create.ErrActionOpening, ResNameClusterAuth, name, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Expiration = fwflex.TimeToFramework(ctx, &expiration)
+	data.Token = types.StringValue(token.Token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	renewAt, diags := e.SetExpiry(ctx, response.Private, expiration)
+	response.Diagnostics.Append(diags...)
+	response.RenewAt = renewAt
+}
+
+type epClusterAuthData struct {
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	Name       types.String      `tfsdk:"name"`
+	RoleARN    fwtypes.ARN       `tfsdk:"role_arn"`
+	Token      types.String      `tfsdk:"token"`
+}
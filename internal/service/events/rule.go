@@ -120,9 +120,12 @@ func resourceRule() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 			names.AttrScheduleExpression: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(0, 256),
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 256),
+					verify.ValidRecurringScheduleExpression,
+				),
 				AtLeastOneOf: []string{names.AttrScheduleExpression, "event_pattern"},
 			},
 			names.AttrState: {
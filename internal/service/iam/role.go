@@ -73,6 +73,7 @@ func resourceRole() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			names.AttrDefaultTagsExclude: tftags.DefaultTagsExcludeSchema(),
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -175,8 +176,9 @@ func resourceRole() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: verify.ValidARN,
 			},
-			names.AttrTags:    tftags.TagsSchema(),
-			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrSkipDefaultTags: tftags.SkipDefaultTagsSchema(),
+			names.AttrTags:            tftags.TagsSchema(),
+			names.AttrTagsAll:         tftags.TagsSchemaComputed(),
 			"unique_id": {
 				Type:     schema.TypeString,
 				Computed: true,
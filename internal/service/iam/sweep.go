@@ -29,7 +29,7 @@ import (
 )
 
 func RegisterSweepers() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -43,7 +43,7 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_openid_connect_provider", sweepOpenIDConnectProvider)
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -55,7 +55,7 @@ func RegisterSweepers() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -92,14 +92,14 @@ func RegisterSweepers() {
 
 	awsv2.Register("aws_iam_signing_certificate", sweepSigningCertificates)
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
 	awsv2.Register("aws_iam_service_linked_role", sweepServiceLinkedRoles)
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 		Dependencies: []string{
@@ -259,7 +259,10 @@ func sweepInstanceProfile(ctx context.Context, client *conns.AWSClient) ([]sweep
 				d.Set(names.AttrRole, roles[0].RoleName)
 			}
 
-			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.Describe(sdk.NewSweepResource(r, d, client), func(info *sweep.ResourceInfo) {
+				info.Name = name
+				info.CreatedAt = instanceProfile.CreateDate
+			}))
 		}
 	}
 
@@ -573,7 +576,10 @@ func sweepServiceLinkedRoles(ctx context.Context, client *conns.AWSClient) ([]sw
 			d := r.Data(nil)
 			d.SetId(aws.ToString(role.Arn))
 
-			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.Describe(sdk.NewSweepResource(r, d, client), func(info *sweep.ResourceInfo) {
+				info.Name = roleName
+				info.CreatedAt = role.CreateDate
+			}))
 		}
 	}
 
@@ -18,7 +18,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -593,83 +592,15 @@ func (model *environmentResourceModel) setID() {
 	model.ID = model.EnvironmentID
 }
 
+func init() {
+	fwflex.RegisterUnionType[awstypes.StorageConfiguration](&awstypes.StorageConfigurationMemberEfs{}, &awstypes.StorageConfigurationMemberFsx{})
+}
+
 type storageConfigurationModel struct {
 	EFS fwtypes.ListNestedObjectValueOf[efsStorageConfigurationModel] `tfsdk:"efs"`
 	FSX fwtypes.ListNestedObjectValueOf[fsxStorageConfigurationModel] `tfsdk:"fsx"`
 }
 
-var (
-	_ fwflex.Expander  = storageConfigurationModel{}
-	_ fwflex.Flattener = &storageConfigurationModel{}
-)
-
-func (m storageConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
-	switch {
-	case !m.EFS.IsNull():
-		efsStorageConfigurationData, d := m.EFS.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.StorageConfigurationMemberEfs
-		diags.Append(fwflex.Expand(ctx, efsStorageConfigurationData, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-
-	case !m.FSX.IsNull():
-		fsxStorageConfigurationData, d := m.FSX.ToPtr(ctx)
-		diags.Append(d...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		var r awstypes.StorageConfigurationMemberFsx
-		diags.Append(fwflex.Expand(ctx, fsxStorageConfigurationData, &r.Value)...)
-		if diags.HasError() {
-			return nil, diags
-		}
-
-		return &r, diags
-	}
-
-	return nil, diags
-}
-
-func (m *storageConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
-	switch t := v.(type) {
-	case awstypes.StorageConfigurationMemberEfs:
-		var model efsStorageConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.EFS = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-
-	case awstypes.StorageConfigurationMemberFsx:
-		var model fsxStorageConfigurationModel
-		d := fwflex.Flatten(ctx, t.Value, &model)
-		diags.Append(d...)
-		if diags.HasError() {
-			return diags
-		}
-
-		m.FSX = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
-
-		return diags
-
-	default:
-		return diags
-	}
-}
-
 type efsStorageConfigurationModel struct {
 	FileSystemID types.String `tfsdk:"file_system_id"`
 	MountPoint   types.String `tfsdk:"mount_point"`
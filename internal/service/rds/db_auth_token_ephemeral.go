@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_rds_db_auth_token", name="DB Auth Token")
+func newEphemeralDBAuthToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralDBAuthToken{}, nil
+}
+
+const (
+	ResNameDBAuthToken = "DB Auth Token"
+)
+
+const (
+	// dbAuthTokenExpiration is how long an IAM database authentication token is valid for.
+	dbAuthTokenExpiration = 15 * time.Minute
+	// emptyPayloadHash is the SHA-256 hash of an empty request body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+var (
+	// dsqlHostnameRegexp matches Aurora DSQL cluster endpoints.
+	dsqlHostnameRegexp = regexache.MustCompile(`\.dsql\.[0-9a-z-]+\.on\.aws$`)
+)
+
+type ephemeralDBAuthToken struct {
+	framework.EphemeralResourceWithConfigure
+	framework.WithRenewBeforeExpiry
+}
+
+func (e *ephemeralDBAuthToken) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_rds_db_auth_token"
+}
+
+func (e *ephemeralDBAuthToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"hostname": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			names.AttrPort: schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (e *ephemeralDBAuthToken) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	var hostname types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("hostname"), &hostname)...)
+	var port types.Int32
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(names.AttrPort), &port)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if hostname.IsNull() || hostname.IsUnknown() || port.IsUnknown() {
+		return
+	}
+
+	// Tokens for all endpoints other than Aurora DSQL cluster endpoints are signed for the host and port.
+	if port.IsNull() && !dsqlHostnameRegexp.MatchString(hostname.ValueString()) {
+		response.Diagnostics.Append(fwdiag.NewAttributeRequiredWhenError(path.Root(names.AttrPort), path.Root("hostname"), hostname.ValueString()))
+	}
+}
+
+func (e *ephemeralDBAuthToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epDBAuthTokenData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Region.IsNull() {
+		data.Region = types.StringValue(e.Meta().Region(ctx))
+	}
+
+	hostname := data.Hostname.ValueString()
+	signingTime := time.Now()
+	token, err := buildDBAuthToken(ctx, e.Meta().CredentialsProvider(ctx), hostname, int(data.Port.ValueInt32()), data.UserName.ValueString(), data.Region.ValueString(), signingTime)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.RDS, create.ErrActionOpening, ResNameDBAuthToken, hostname, err),
+			err.Error(),
+		)
+		return
+	}
+
+	expiration := signingTime.Add(dbAuthTokenExpiration)
+	data.Expiration = fwflex.TimeToFramework(ctx, &expiration)
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	renewAt, diags := e.SetExpiry(ctx, response.Private, expiration)
+	response.Diagnostics.Append(diags...)
+	response.RenewAt = renewAt
+}
+
+// buildDBAuthToken returns an IAM database authentication token, which is a SigV4 presigned URL without its scheme.
+// Tokens for Aurora DSQL cluster endpoints are signed for the "dsql" service and do not include the port;
+// the "admin" user is authenticated with the DbConnectAdmin action and other users with DbConnect.
+// All other endpoints, including RDS Proxy endpoints, are signed for the "rds-db" service.
+// Signing is local and makes no AWS API calls.
+func buildDBAuthToken(ctx context.Context, credentialsProvider aws.CredentialsProvider, hostname string, port int, userName, region string, signingTime time.Time) (string, error) {
+	if credentialsProvider == nil {
+		return "", fmt.Errorf("no credentials provider")
+	}
+	if region == "" {
+		return "", fmt.Errorf("region is required")
+	}
+
+	var endpoint, service string
+	values := url.Values{}
+	if dsqlHostnameRegexp.MatchString(hostname) {
+		endpoint, service = hostname, "dsql"
+		action := "DbConnect"
+		if userName == "admin" {
+			action = "DbConnectAdmin"
+		}
+		values.Set("Action", action)
+	} else {
+		if port == 0 {
+			return "", fmt.Errorf("port is required for %s", hostname)
+		}
+		endpoint, service = net.JoinHostPort(hostname, strconv.Itoa(port)), "rds-db"
+		values.Set("Action", "connect")
+		values.Set("DBUser", userName)
+	}
+	values.Set("X-Amz-Expires", strconv.Itoa(int(dbAuthTokenExpiration/time.Second)))
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+endpoint+"/", nil)
+	if err != nil {
+		return "", err
+	}
+	request.URL.RawQuery = values.Encode()
+
+	credentials, err := credentialsProvider.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieving credentials: %w", err)
+	}
+
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, request, emptyPayloadHash, service, region, signingTime)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(signedURI, "https://"), nil
+}
+
+type epDBAuthTokenData struct {
+	Expiration timetypes.RFC3339 `tfsdk:"expiration"`
+	Hostname   types.String      `tfsdk:"hostname"`
+	Port       types.Int32       `tfsdk:"port"`
+	Region     types.String      `tfsdk:"region"`
+	Token      types.String      `tfsdk:"token"`
+	UserName   types.String      `tfsdk:"user_name"`
+}
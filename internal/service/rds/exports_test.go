@@ -33,6 +33,7 @@ var (
 	ResourceSnapshotCopy                        = resourceSnapshotCopy
 	ResourceSubnetGroup                         = resourceSubnetGroup
 
+	BuildDBAuthToken                           = buildDBAuthToken
 	ClusterIDAndRegionFromARN                  = clusterIDAndRegionFromARN
 	FindCustomDBEngineVersionByTwoPartKey      = findCustomDBEngineVersionByTwoPartKey
 	FindDBClusterByID                          = findDBClusterByID
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+// Exports for use in tests only.
+var (
+	FindResourceTagMappingsByARNs = findResourceTagMappingsByARNs
+	MatchingTags                  = matchingTags
+	TagResources                  = tagResources
+	UntagResources                = untagResources
+)
@@ -0,0 +1,559 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// See https://docs.aws.amazon.com/resourcegroupstagging/latest/APIReference/API_TagResources.html.
+	tagResourcesMaxResourceARNs = 20
+	tagResourcesMaxTags         = 50
+	// See https://docs.aws.amazon.com/resourcegroupstagging/latest/APIReference/API_GetResources.html.
+	getResourcesMaxResourceARNs = 100
+)
+
+// @FrameworkResource("aws_resourcegroupstaggingapi_tags", name="Tags")
+func newTagsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &tagsResource{}
+
+	return r, nil
+}
+
+type tagsResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*tagsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_resourcegroupstaggingapi_tags"
+}
+
+func (r *tagsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"failed_resources": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[failedResourceModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[failedResourceModel](ctx),
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"matched_resource_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"resource_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.NoNullValues(),
+				},
+			},
+			"resource_type_filters": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(100),
+					setvalidator.ConflictsWith(path.MatchRoot("resource_arns")),
+				},
+			},
+			names.AttrTags: tftags.TagsAttributeRequired(),
+		},
+		Blocks: map[string]schema.Block{
+			"tag_filter": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tagFilterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(50),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValues: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtMost(20),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *tagsResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("resource_arns"),
+			path.MatchRoot("tag_filter"),
+		),
+	}
+}
+
+func (r *tagsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data tagsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	resourceARNs, err := data.findResourceARNs(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Resource Groups Tagging API Tags", err.Error())
+
+		return
+	}
+
+	failures, err := tagResources(ctx, conn, resourceARNs, tftags.New(ctx, data.Tags))
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Resource Groups Tagging API Tags", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(sdkid.UniqueId())
+	response.Diagnostics.Append(fwflex.Flatten(ctx, resourceARNs, &data.MatchedResourceARNs)...)
+	response.Diagnostics.Append(data.setFailedResources(ctx, failures, &response.Diagnostics)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *tagsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data tagsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	var mappings []awstypes.ResourceTagMapping
+	var err error
+	if data.TagFilters.IsNull() {
+		mappings, err = findResourceTagMappingsByARNs(ctx, conn, fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceARNs))
+	} else {
+		mappings, err = findResourceTagMappings(ctx, conn, data.getResourcesInput(ctx))
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resource Groups Tagging API Tags (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	var resourceARNs []string
+	if data.TagFilters.IsNull() {
+		resourceARNs = fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceARNs)
+	} else {
+		for _, v := range mappings {
+			resourceARNs = append(resourceARNs, aws.ToString(v.ResourceARN))
+		}
+	}
+
+	// Resources that have been deleted or never tagged are not returned by GetResources.
+	tagsByARN := make(map[string]tftags.KeyValueTags, len(mappings))
+	for _, v := range mappings {
+		tagsByARN[aws.ToString(v.ResourceARN)] = KeyValueTags(ctx, v.Tags)
+	}
+
+	failedResources, d := data.FailedResources.ToSlice(ctx)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	failedARNs := tfslices.ApplyToAll(failedResources, func(v *failedResourceModel) string {
+		return v.ResourceARN.ValueString()
+	})
+
+	// A tag is only kept in state if every matched resource has it with the desired value.
+	// Any drift then shows as a difference that the next apply corrects.
+	// Resources that no longer exist or that could not be tagged would never converge, so they are skipped.
+	tags := tftags.New(ctx, data.Tags)
+	for _, arn := range resourceARNs {
+		if slices.Contains(failedARNs, arn) {
+			response.Diagnostics.AddWarning(
+				fmt.Sprintf("Resource Groups Tagging API Tags: resource (%s) not read", arn),
+				"The resource could not be tagged or untagged in the last apply. Its tags are not compared with the configured tags.",
+			)
+			continue
+		}
+
+		resourceTags, ok := tagsByARN[arn]
+		if !ok {
+			response.Diagnostics.AddWarning(
+				fmt.Sprintf("Resource Groups Tagging API Tags: resource (%s) not found", arn),
+				"The resource was not returned by GetResources and may have been deleted. Its tags are not compared with the configured tags.",
+			)
+			continue
+		}
+
+		tags = matchingTags(tags, resourceTags)
+	}
+
+	data.Tags = tftags.FlattenStringValueMap(ctx, tags.Map())
+	response.Diagnostics.Append(fwflex.Flatten(ctx, resourceARNs, &data.MatchedResourceARNs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tagsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new tagsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	resourceARNs, err := new.findResourceARNs(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Resource Groups Tagging API Tags (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	oldTags, newTags := tftags.New(ctx, old.Tags), tftags.New(ctx, new.Tags)
+	failures := make(map[string]awstypes.FailureInfo)
+
+	// Resources that are no longer matched have all previously applied tags removed.
+	// Resources that are still matched have only the tags no longer configured removed.
+	var unmatchedARNs, rematchedARNs []string
+	for _, arn := range fwflex.ExpandFrameworkStringValueSet(ctx, old.MatchedResourceARNs) {
+		if slices.Contains(resourceARNs, arn) {
+			rematchedARNs = append(rematchedARNs, arn)
+		} else {
+			unmatchedARNs = append(unmatchedARNs, arn)
+		}
+	}
+
+	for _, v := range []struct {
+		resourceARNs []string
+		tagKeys      []string
+	}{
+		{unmatchedARNs, oldTags.Keys()},
+		{rematchedARNs, oldTags.Removed(newTags).Keys()},
+	} {
+		output, err := untagResources(ctx, conn, v.resourceARNs, v.tagKeys)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Resource Groups Tagging API Tags (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		for k, v := range output {
+			failures[k] = v
+		}
+	}
+
+	// Re-apply all configured tags so that any drift is corrected.
+	output, err := tagResources(ctx, conn, resourceARNs, newTags)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Resource Groups Tagging API Tags (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	for k, v := range output {
+		failures[k] = v
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, resourceARNs, &new.MatchedResourceARNs)...)
+	response.Diagnostics.Append(new.setFailedResources(ctx, failures, &response.Diagnostics)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *tagsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data tagsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	failures, err := untagResources(ctx, conn, fwflex.ExpandFrameworkStringValueSet(ctx, data.MatchedResourceARNs), tftags.New(ctx, data.Tags).Keys())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Resource Groups Tagging API Tags (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	addFailureWarnings(failures, &response.Diagnostics)
+}
+
+func (r *tagsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan tagsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Resources matched by a tag filter query are only known after apply.
+	if plan.TagFilters.IsNull() && !plan.ResourceARNs.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("matched_resource_arns"), plan.ResourceARNs)...)
+	}
+}
+
+// findResourceARNs returns the ARNs of the resources to be tagged.
+func (data *tagsResourceModel) findResourceARNs(ctx context.Context, conn *resourcegroupstaggingapi.Client) ([]string, error) {
+	if data.TagFilters.IsNull() {
+		return fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceARNs), nil
+	}
+
+	mappings, err := findResourceTagMappings(ctx, conn, data.getResourcesInput(ctx))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var resourceARNs []string
+	for _, v := range mappings {
+		resourceARNs = append(resourceARNs, aws.ToString(v.ResourceARN))
+	}
+
+	return resourceARNs, nil
+}
+
+func (data *tagsResourceModel) getResourcesInput(ctx context.Context) *resourcegroupstaggingapi.GetResourcesInput {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceTypeFilters),
+	}
+
+	tagFilters, _ := data.TagFilters.ToSlice(ctx)
+	for _, v := range tagFilters {
+		input.TagFilters = append(input.TagFilters, awstypes.TagFilter{
+			Key:    fwflex.StringFromFramework(ctx, v.Key),
+			Values: fwflex.ExpandFrameworkStringValueSet(ctx, v.Values),
+		})
+	}
+
+	return input
+}
+
+// setFailedResources records per-resource failures and reports each as a warning.
+// Failed resources are not tagged as configured and are skipped when next read.
+func (data *tagsResourceModel) setFailedResources(ctx context.Context, failures map[string]awstypes.FailureInfo, diags *diag.Diagnostics) diag.Diagnostics {
+	var failedResources []failedResourceModel
+	for _, arn := range slices.Sorted(maps.Keys(failures)) {
+		v := failures[arn]
+		failedResources = append(failedResources, failedResourceModel{
+			ErrorCode:    fwflex.StringValueToFramework(ctx, v.ErrorCode),
+			ErrorMessage: fwflex.StringToFramework(ctx, v.ErrorMessage),
+			ResourceARN:  types.StringValue(arn),
+			StatusCode:   fwflex.Int32ValueToFramework(ctx, v.StatusCode),
+		})
+	}
+
+	addFailureWarnings(failures, diags)
+
+	var d diag.Diagnostics
+	data.FailedResources, d = fwtypes.NewListNestedObjectValueOfValueSlice(ctx, failedResources)
+
+	return d
+}
+
+func addFailureWarnings(failures map[string]awstypes.FailureInfo, diags *diag.Diagnostics) {
+	for _, arn := range slices.Sorted(maps.Keys(failures)) {
+		v := failures[arn]
+		diags.AddWarning(
+			fmt.Sprintf("Resource Groups Tagging API Tags: resource (%s) not updated", arn),
+			fmt.Sprintf("%s (%d): %s", v.ErrorCode, v.StatusCode, aws.ToString(v.ErrorMessage)),
+		)
+	}
+}
+
+// matchingTags returns the tags that have the same value in have.
+func matchingTags(want, have tftags.KeyValueTags) tftags.KeyValueTags {
+	result := make(tftags.KeyValueTags)
+
+	for k, v := range want {
+		if v, h := v.ValueString(), have.KeyValue(k); h != nil && *h == v {
+			result[k] = want[k]
+		}
+	}
+
+	return result
+}
+
+// tagResources applies tags to resources in chunks of the maximum size the API allows.
+// Per-resource failures are returned keyed by resource ARN.
+func tagResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, resourceARNs []string, tags tftags.KeyValueTags) (map[string]awstypes.FailureInfo, error) {
+	failures := make(map[string]awstypes.FailureInfo)
+	tags = tags.IgnoreAWS()
+
+	if len(resourceARNs) == 0 || len(tags) == 0 {
+		return failures, nil
+	}
+
+	for chunk := range slices.Chunk(resourceARNs, tagResourcesMaxResourceARNs) {
+		for _, tags := range tags.Chunks(tagResourcesMaxTags) {
+			input := &resourcegroupstaggingapi.TagResourcesInput{
+				ResourceARNList: chunk,
+				Tags:            tags.Map(),
+			}
+
+			output, err := conn.TagResources(ctx, input)
+
+			if err != nil {
+				return nil, fmt.Errorf("tagging resources: %w", err)
+			}
+
+			for k, v := range output.FailedResourcesMap {
+				failures[k] = v
+			}
+		}
+	}
+
+	return failures, nil
+}
+
+// untagResources removes tags from resources in chunks of the maximum size the API allows.
+// Per-resource failures are returned keyed by resource ARN.
+func untagResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, resourceARNs []string, tagKeys []string) (map[string]awstypes.FailureInfo, error) {
+	failures := make(map[string]awstypes.FailureInfo)
+
+	if len(resourceARNs) == 0 || len(tagKeys) == 0 {
+		return failures, nil
+	}
+
+	for chunk := range slices.Chunk(resourceARNs, tagResourcesMaxResourceARNs) {
+		for tagKeys := range slices.Chunk(tagKeys, tagResourcesMaxTags) {
+			input := &resourcegroupstaggingapi.UntagResourcesInput{
+				ResourceARNList: chunk,
+				TagKeys:         tagKeys,
+			}
+
+			output, err := conn.UntagResources(ctx, input)
+
+			if err != nil {
+				return nil, fmt.Errorf("untagging resources: %w", err)
+			}
+
+			for k, v := range output.FailedResourcesMap {
+				failures[k] = v
+			}
+		}
+	}
+
+	return failures, nil
+}
+
+func findResourceTagMappingsByARNs(ctx context.Context, conn *resourcegroupstaggingapi.Client, resourceARNs []string) ([]awstypes.ResourceTagMapping, error) {
+	var output []awstypes.ResourceTagMapping
+
+	for chunk := range slices.Chunk(resourceARNs, getResourcesMaxResourceARNs) {
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: chunk,
+		}
+
+		mappings, err := findResourceTagMappings(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, mappings...)
+	}
+
+	return output, nil
+}
+
+func findResourceTagMappings(ctx context.Context, conn *resourcegroupstaggingapi.Client, input *resourcegroupstaggingapi.GetResourcesInput) ([]awstypes.ResourceTagMapping, error) {
+	var output []awstypes.ResourceTagMapping
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResourceTagMappingList...)
+	}
+
+	return output, nil
+}
+
+type tagsResourceModel struct {
+	FailedResources     fwtypes.ListNestedObjectValueOf[failedResourceModel] `tfsdk:"failed_resources"`
+	ID                  types.String                                         `tfsdk:"id"`
+	MatchedResourceARNs fwtypes.SetOfString                                  `tfsdk:"matched_resource_arns"`
+	ResourceARNs        fwtypes.SetOfString                                  `tfsdk:"resource_arns"`
+	ResourceTypeFilters fwtypes.SetOfString                                  `tfsdk:"resource_type_filters"`
+	TagFilters          fwtypes.ListNestedObjectValueOf[tagFilterModel]      `tfsdk:"tag_filter"`
+	Tags                tftags.Map                                           `tfsdk:"tags"`
+}
+
+type failedResourceModel struct {
+	ErrorCode    types.String `tfsdk:"error_code"`
+	ErrorMessage types.String `tfsdk:"error_message"`
+	ResourceARN  types.String `tfsdk:"resource_arn"`
+	StatusCode   types.Int64  `tfsdk:"status_code"`
+}
+
+type tagFilterModel struct {
+	Key    types.String        `tfsdk:"key"`
+	Values fwtypes.SetOfString `tfsdk:"values"`
+}
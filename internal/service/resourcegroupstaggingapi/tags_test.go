@@ -0,0 +1,529 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestMatchingTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := map[string]struct {
+		want     map[string]string
+		have     map[string]string
+		expected map[string]string
+	}{
+		"all match": {
+			want:     map[string]string{"key1": "value1", "key2": "value2"},
+			have:     map[string]string{"key1": "value1", "key2": "value2", "key3": "value3"},
+			expected: map[string]string{"key1": "value1", "key2": "value2"},
+		},
+		"missing and different": {
+			want:     map[string]string{"key1": "value1", "key2": "value2", "key3": "value3"},
+			have:     map[string]string{"key1": "value1", "key2": "other"},
+			expected: map[string]string{"key1": "value1"},
+		},
+		"empty value": {
+			want:     map[string]string{"key1": ""},
+			have:     map[string]string{"key1": ""},
+			expected: map[string]string{"key1": ""},
+		},
+		"no tags": {
+			want:     map[string]string{"key1": "value1"},
+			expected: map[string]string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfresourcegroupstaggingapi.MatchingTags(tftags.New(ctx, testCase.want), tftags.New(ctx, testCase.have))
+
+			if diff := cmp.Diff(got.Map(), testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTagResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := map[string]struct {
+		resourceARNCount int
+		tagCount         int
+		expectedRequests int
+	}{
+		"no resources": {
+			resourceARNCount: 0,
+			tagCount:         1,
+			expectedRequests: 0,
+		},
+		"only AWS tags": {
+			resourceARNCount: 1,
+			tagCount:         0,
+			expectedRequests: 0,
+		},
+		"maximum request": {
+			resourceARNCount: 20,
+			tagCount:         50,
+			expectedRequests: 1,
+		},
+		"chunked resources": {
+			resourceARNCount: 21,
+			tagCount:         50,
+			expectedRequests: 2,
+		},
+		"chunked tags": {
+			resourceARNCount: 20,
+			tagCount:         51,
+			expectedRequests: 2,
+		},
+		"chunked resources and tags": {
+			resourceARNCount: 41,
+			tagCount:         101,
+			expectedRequests: 9,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, requests := testTaggingAPIClient(t)
+			resourceARNs, tagKeys := testTaggingAPIResourceARNs(testCase.resourceARNCount), testTaggingAPITagKeys(testCase.tagCount)
+
+			// AWS reserved tags are never applied.
+			tags := map[string]string{"aws:cloudformation:stack-name": "test"}
+			for _, k := range tagKeys {
+				tags[k] = "value"
+			}
+
+			failures, err := tfresourcegroupstaggingapi.TagResources(ctx, conn, resourceARNs, tftags.New(ctx, tags))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			testCheckTaggingAPIRequests(t, *requests, testCase.expectedRequests, resourceARNs, tagKeys)
+			testCheckTaggingAPIFailures(t, failures, testCase.expectedRequests)
+
+			for _, request := range *requests {
+				for k, v := range request.Tags {
+					if v != tags[k] {
+						t.Errorf("tag %s got: %q, expected: %q", k, v, tags[k])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestUntagResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := map[string]struct {
+		resourceARNCount int
+		tagKeyCount      int
+		expectedRequests int
+	}{
+		"no resources": {
+			resourceARNCount: 0,
+			tagKeyCount:      1,
+			expectedRequests: 0,
+		},
+		"no tag keys": {
+			resourceARNCount: 1,
+			tagKeyCount:      0,
+			expectedRequests: 0,
+		},
+		"maximum request": {
+			resourceARNCount: 20,
+			tagKeyCount:      50,
+			expectedRequests: 1,
+		},
+		"chunked resources": {
+			resourceARNCount: 21,
+			tagKeyCount:      50,
+			expectedRequests: 2,
+		},
+		"chunked tag keys": {
+			resourceARNCount: 20,
+			tagKeyCount:      51,
+			expectedRequests: 2,
+		},
+		"chunked resources and tag keys": {
+			resourceARNCount: 41,
+			tagKeyCount:      101,
+			expectedRequests: 9,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, requests := testTaggingAPIClient(t)
+			resourceARNs, tagKeys := testTaggingAPIResourceARNs(testCase.resourceARNCount), testTaggingAPITagKeys(testCase.tagKeyCount)
+
+			failures, err := tfresourcegroupstaggingapi.UntagResources(ctx, conn, resourceARNs, tagKeys)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			testCheckTaggingAPIRequests(t, *requests, testCase.expectedRequests, resourceARNs, tagKeys)
+			testCheckTaggingAPIFailures(t, failures, testCase.expectedRequests)
+		})
+	}
+}
+
+func TestAccResourceGroupsTaggingAPITags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourcegroupstaggingapi_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsConfig_resourceARNs(rName, "key1", "value1", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTagsApplied(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "failed_resources.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "matched_resource_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTagsConfig_resourceARNs(rName, "key1", "value1updated", "key3", "value3"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTagsApplied(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "failed_resources.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "matched_resource_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key3", "value3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPITags_tagFilter(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourcegroupstaggingapi_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsConfig_tagFilter(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTagsApplied(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "failed_resources.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "matched_resource_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "matched_resource_arns.*", "aws_vpc.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "matched_resource_arns.*", "aws_vpc.test.1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTagsApplied(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		resourceARNs, tags := testAccTagsAttributes(rs.Primary.Attributes)
+		mappings, err := tfresourcegroupstaggingapi.FindResourceTagMappingsByARNs(ctx, conn, resourceARNs)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(mappings), len(resourceARNs); got != want {
+			return fmt.Errorf("Resource Groups Tagging API Tags %s: %d resources tagged, want %d", rs.Primary.ID, got, want)
+		}
+
+		for _, v := range mappings {
+			if !tfresourcegroupstaggingapi.KeyValueTags(ctx, v.Tags).ContainsAll(tags) {
+				return fmt.Errorf("Resource Groups Tagging API Tags %s: resource (%s) missing tags", rs.Primary.ID, aws.ToString(v.ResourceARN))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTagsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resourcegroupstaggingapi_tags" {
+				continue
+			}
+
+			resourceARNs, tags := testAccTagsAttributes(rs.Primary.Attributes)
+			mappings, err := tfresourcegroupstaggingapi.FindResourceTagMappingsByARNs(ctx, conn, resourceARNs)
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range mappings {
+				if len(tfresourcegroupstaggingapi.KeyValueTags(ctx, v.Tags).Only(tags)) > 0 {
+					return fmt.Errorf("Resource Groups Tagging API Tags %s: resource (%s) still tagged", rs.Primary.ID, aws.ToString(v.ResourceARN))
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccTagsAttributes returns the matched resource ARNs and the tags from an aws_resourcegroupstaggingapi_tags resource's state.
+func testAccTagsAttributes(attributes map[string]string) ([]string, tftags.KeyValueTags) {
+	var resourceARNs []string
+	tags := make(map[string]string)
+
+	for k, v := range attributes {
+		if k == "matched_resource_arns.#" || k == acctest.CtTagsPercent {
+			continue
+		}
+
+		if _, ok := strings.CutPrefix(k, "matched_resource_arns."); ok {
+			resourceARNs = append(resourceARNs, v)
+		} else if k, ok := strings.CutPrefix(k, "tags."); ok {
+			tags[k] = v
+		}
+	}
+
+	return resourceARNs, tftags.New(context.Background(), tags)
+}
+
+// testTaggingAPIRequest is the body of a TagResources or UntagResources request.
+type testTaggingAPIRequest struct {
+	ResourceARNList []string
+	TagKeys         []string
+	Tags            map[string]string
+}
+
+// testTaggingAPIClient returns a client for a test server that records TagResources and UntagResources requests.
+// Each request fails for the first test resource.
+func testTaggingAPIClient(t *testing.T) (*resourcegroupstaggingapi.Client, *[]testTaggingAPIRequest) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var requests []testTaggingAPIRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request testTaggingAPIRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		requests = append(requests, request)
+		mu.Unlock()
+
+		failures := make(map[string]awstypes.FailureInfo)
+		if arn := testTaggingAPIResourceARNs(1)[0]; slices.Contains(request.ResourceARNList, arn) {
+			failures[arn] = awstypes.FailureInfo{
+				ErrorCode:    awstypes.ErrorCodeInvalidParameterException,
+				ErrorMessage: aws.String("test failure"),
+				StatusCode:   http.StatusBadRequest,
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		if err := json.NewEncoder(w).Encode(map[string]any{"FailedResourcesMap": failures}); err != nil {
+			t.Error(err)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	conn := resourcegroupstaggingapi.NewFromConfig(aws.Config{
+		Credentials: credentials.NewStaticCredentialsProvider("AKIATEST", "secret", ""),
+		Region:      "us-west-2", // lintignore:AWSAT003
+	}, func(o *resourcegroupstaggingapi.Options) {
+		o.BaseEndpoint = aws.String(server.URL)
+	})
+
+	return conn, &requests
+}
+
+func testTaggingAPIResourceARNs(n int) []string {
+	var resourceARNs []string
+	for i := range n {
+		resourceARNs = append(resourceARNs, fmt.Sprintf("arn:aws:s3:::test-bucket-%d", i)) // lintignore:AWSAT005
+	}
+
+	return resourceARNs
+}
+
+func testTaggingAPITagKeys(n int) []string {
+	var tagKeys []string
+	for i := range n {
+		tagKeys = append(tagKeys, fmt.Sprintf("key%d", i))
+	}
+
+	return tagKeys
+}
+
+// testCheckTaggingAPIRequests checks that requests are within the API limits and cover every resource and tag key exactly once.
+func testCheckTaggingAPIRequests(t *testing.T, requests []testTaggingAPIRequest, expectedRequests int, resourceARNs, tagKeys []string) {
+	t.Helper()
+
+	if got, expected := len(requests), expectedRequests; got != expected {
+		t.Fatalf("requests got: %d, expected: %d", got, expected)
+	}
+
+	covered := make(map[string]int)
+	for _, v := range requests {
+		if got, limit := len(v.ResourceARNList), 20; got > limit {
+			t.Errorf("request resource ARNs got: %d, limit: %d", got, limit)
+		}
+
+		keys := v.TagKeys
+		if v.Tags != nil {
+			keys = slices.Collect(maps.Keys(v.Tags))
+		}
+		if got, limit := len(keys), 50; got > limit {
+			t.Errorf("request tags got: %d, limit: %d", got, limit)
+		}
+
+		for _, arn := range v.ResourceARNList {
+			for _, key := range keys {
+				covered[arn+" "+key]++
+			}
+		}
+	}
+
+	if expectedRequests == 0 {
+		return
+	}
+
+	for _, arn := range resourceARNs {
+		for _, key := range tagKeys {
+			if got := covered[arn+" "+key]; got != 1 {
+				t.Errorf("resource (%s) tag (%s) requests got: %d, expected: 1", arn, key, got)
+			}
+		}
+	}
+	if got, expected := len(covered), len(resourceARNs)*len(tagKeys); got != expected {
+		t.Errorf("resource tags got: %d, expected: %d", got, expected)
+	}
+}
+
+// testCheckTaggingAPIFailures checks that the per-resource failures of all requests are returned.
+func testCheckTaggingAPIFailures(t *testing.T, failures map[string]awstypes.FailureInfo, expectedRequests int) {
+	t.Helper()
+
+	var expected []string
+	if expectedRequests > 0 {
+		expected = testTaggingAPIResourceARNs(1)
+	}
+
+	if diff := cmp.Diff(slices.Collect(maps.Keys(failures)), expected, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("unexpected failures diff (+wanted, -got): %s", diff)
+	}
+}
+
+func testAccTagsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = 2
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+`, rName)
+}
+
+func testAccTagsConfig_resourceARNs(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccTagsConfig_base(rName), fmt.Sprintf(`
+resource "aws_resourcegroupstaggingapi_tags" "test" {
+  resource_arns = aws_vpc.test[*].arn
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccTagsConfig_tagFilter(rName string) string {
+	return acctest.ConfigCompose(testAccTagsConfig_base(rName), fmt.Sprintf(`
+resource "aws_resourcegroupstaggingapi_tags" "test" {
+  resource_type_filters = ["ec2:vpc"]
+
+  tag_filter {
+    key    = "Name"
+    values = [%[1]q]
+  }
+
+  tags = {
+    Owner = %[1]q
+  }
+
+  depends_on = [aws_vpc.test]
+}
+`, rName))
+}
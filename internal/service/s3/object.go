@@ -140,6 +140,7 @@ func resourceObject() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			names.AttrDefaultTagsExclude: tftags.DefaultTagsExcludeSchema(),
 			"etag": {
 				Type: schema.TypeString,
 				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
@@ -217,6 +218,7 @@ func resourceObject() *schema.Resource {
 				Computed:         true,
 				ValidateDiagFunc: enum.Validate[types.ServerSideEncryption](),
 			},
+			names.AttrSkipDefaultTags: tftags.SkipDefaultTagsSchema(),
 			names.AttrSource: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -564,7 +566,7 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.StorageClass = types.StorageClass(v.(string))
 	}
 
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig(ctx)
+	defaultTagsConfig := tftags.ResourceDefaultConfig(meta.(*conns.AWSClient).DefaultTagsConfig(ctx), d)
 	tags := tftags.New(ctx, getContextTags(ctx))
 	if ignoreProviderDefaultTags(ctx, d) {
 		tags = tags.RemoveDefaultConfig(defaultTagsConfig)
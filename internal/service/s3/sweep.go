@@ -185,7 +185,10 @@ func sweepBuckets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepab
 			d := r.Data(nil)
 			d.SetId(aws.ToString(bucket.Name))
 
-			sweepResources = append(sweepResources, sdk.NewSweepResource(r, d, client))
+			sweepResources = append(sweepResources, sweep.Describe(sdk.NewSweepResource(r, d, client), func(info *sweep.ResourceInfo) {
+				info.Name = aws.ToString(bucket.Name)
+				info.CreatedAt = bucket.CreationDate
+			}))
 		}
 	}
 
@@ -239,9 +242,12 @@ func sweepDirectoryBuckets(ctx context.Context, client *conns.AWSClient) ([]swee
 				continue
 			}
 
-			sweepResources = append(sweepResources, framework.NewSweepResource(newDirectoryBucketResource, client,
+			sweepResources = append(sweepResources, sweep.Describe(framework.NewSweepResource(newDirectoryBucketResource, client,
 				framework.NewAttribute(names.AttrID, aws.ToString(bucket.Name)),
-			))
+			), func(info *sweep.ResourceInfo) {
+				info.Name = aws.ToString(bucket.Name)
+				info.CreatedAt = bucket.CreationDate
+			}))
 		}
 	}
 
@@ -124,9 +124,12 @@ func sweepTableBuckets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sw
 		}
 
 		for _, bucket := range page.TableBuckets {
-			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceTableBucket, client,
+			sweepResources = append(sweepResources, sweep.Describe(framework.NewSweepResource(newResourceTableBucket, client,
 				framework.NewAttribute(names.AttrARN, aws.ToString(bucket.Arn)),
-			))
+			), func(info *sweep.ResourceInfo) {
+				info.Name = aws.ToString(bucket.Name)
+				info.CreatedAt = bucket.CreatedAt
+			}))
 		}
 	}
 
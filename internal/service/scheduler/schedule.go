@@ -115,9 +115,12 @@ func resourceSchedule() *schema.Resource {
 				)),
 			},
 			names.AttrScheduleExpression: {
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 256)),
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.All(
+					validation.StringLenBetween(1, 256),
+					verify.ValidScheduleExpression,
+				)),
 			},
 			"schedule_expression_timezone": {
 				Type:             schema.TypeString,
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_sts_assume_role", name="Assume Role")
+func newEphemeralAssumeRole(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAssumeRole{}, nil
+}
+
+const (
+	ResNameAssumeRole = "Assume Role"
+)
+
+type ephemeralAssumeRole struct {
+	framework.EphemeralResourceWithConfigure
+	framework.WithRenewBeforeExpiry
+}
+
+func (e *ephemeralAssumeRole) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_sts_assume_role"
+}
+
+func (e *ephemeralAssumeRole) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_role_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"duration": schema.StringAttribute{
+				CustomType:  fwtypes.DurationType,
+				Optional:    true,
+				Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+				Validators: []validator.String{
+					fwvalidators.DurationBetween(15*time.Minute, 12*time.Hour),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrExternalID: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 1224),
+				},
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Optional:   true,
+			},
+			"policy_arns": schema.SetAttribute{
+				CustomType: fwtypes.SetOfARNType,
+				Optional:   true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+				},
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"source_identity": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Optional:   true,
+			},
+			"transitive_tag_keys": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAssumeRole) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAssumeRoleData
+	conn := e.Meta().STSClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SessionName.IsNull() {
+		data.SessionName = types.StringValue(sdkid.PrefixedUniqueId("terraform-"))
+	}
+
+	input := sts.AssumeRoleInput{
+		ExternalId:        fwflex.StringFromFramework(ctx, data.ExternalID),
+		Policy:            fwflex.StringFromFramework(ctx, data.Policy),
+		RoleArn:           fwflex.StringFromFramework(ctx, data.RoleARN),
+		RoleSessionName:   fwflex.StringFromFramework(ctx, data.SessionName),
+		SourceIdentity:    fwflex.StringFromFramework(ctx, data.SourceIdentity),
+		TransitiveTagKeys: fwflex.ExpandFrameworkStringValueSet(ctx, data.TransitiveTagKeys),
+	}
+
+	if v := data.Duration.ValueDuration(); v > 0 {
+		input.DurationSeconds = aws.Int32(int32(v / time.Second))
+	}
+
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs) {
+		input.PolicyArns = append(input.PolicyArns, awstypes.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+
+	for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags) {
+		input.Tags = append(input.Tags, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	output, err := conn.AssumeRole(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.STS, create.ErrActionOpening, ResNameAssumeRole, data.RoleARN.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	credentials := output.Credentials
+	data.AccessKeyID = fwflex.StringToFramework(ctx, credentials.AccessKeyId)
+	data.Expiration = fwflex.TimeToFramework(ctx, credentials.Expiration)
+	data.SecretAccessKey = fwflex.StringToFramework(ctx, credentials.SecretAccessKey)
+	data.SessionToken = fwflex.StringToFramework(ctx, credentials.SessionToken)
+	if v := output.AssumedRoleUser; v != nil {
+		data.AssumedRoleARN = fwflex.StringToFramework(ctx, v.Arn)
+		data.AssumedRoleID = fwflex.StringToFramework(ctx, v.AssumedRoleId)
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	renewAt, diags := e.SetExpiry(ctx, response.Private, aws.ToTime(credentials.Expiration))
+	response.Diagnostics.Append(diags...)
+	response.RenewAt = renewAt
+}
+
+type epAssumeRoleData struct {
+	AccessKeyID       types.String        `tfsdk:"access_key_id"`
+	AssumedRoleARN    types.String        `tfsdk:"assumed_role_arn"`
+	AssumedRoleID     types.String        `tfsdk:"assumed_role_id"`
+	Duration          fwtypes.Duration    `tfsdk:"duration"`
+	Expiration        timetypes.RFC3339   `tfsdk:"expiration"`
+	ExternalID        types.String        `tfsdk:"external_id"`
+	Policy            fwtypes.IAMPolicy   `tfsdk:"policy"`
+	PolicyARNs        fwtypes.SetOfARN    `tfsdk:"policy_arns"`
+	RoleARN           fwtypes.ARN         `tfsdk:"role_arn"`
+	SecretAccessKey   types.String        `tfsdk:"secret_access_key"`
+	SessionName       types.String        `tfsdk:"session_name"`
+	SessionToken      types.String        `tfsdk:"session_token"`
+	SourceIdentity    types.String        `tfsdk:"source_identity"`
+	Tags              fwtypes.MapOfString `tfsdk:"tags"`
+	TransitiveTagKeys fwtypes.SetOfString `tfsdk:"transitive_tag_keys"`
+}
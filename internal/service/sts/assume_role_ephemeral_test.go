@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sts_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSTSAssumeRoleEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dp := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.STSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssumeRoleEphemeralConfig_base(rName),
+			},
+			{
+				PreConfig: func() {
+					testAccWaitRoleAssumable(ctx, t, rName)
+				},
+				Config: testAccAssumeRoleEphemeralConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey("access_key_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey("assumed_role_arn"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey("expiration"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey("secret_access_key"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey("session_name"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey("session_token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSTSAssumeRoleEphemeral_sessionTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dp := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.STSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssumeRoleEphemeralConfig_base(rName),
+			},
+			{
+				PreConfig: func() {
+					testAccWaitRoleAssumable(ctx, t, rName)
+				},
+				Config: testAccAssumeRoleEphemeralConfig_sessionTags(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey("access_key_id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey("source_identity"), knownvalue.StringExact(rName)),
+					statecheck.ExpectKnownValue(echoResourceName, dp.AtMapKey(names.AttrTags), knownvalue.MapExact(map[string]knownvalue.Check{
+						"team": knownvalue.StringExact("example"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+// testAccWaitRoleAssumable waits until the newly created IAM role can be assumed, as IAM is eventually consistent.
+func testAccWaitRoleAssumable(ctx context.Context, t *testing.T, rName string) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).STSClient(ctx)
+	roleARN := arn.ARN{
+		Partition: acctest.Partition(),
+		Service:   "iam",
+		AccountID: acctest.AccountID(ctx),
+		Resource:  "role/" + rName,
+	}.String()
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 2*time.Minute, func() (interface{}, error) {
+		return conn.AssumeRole(ctx, &sts.AssumeRoleInput{
+			RoleArn:         aws.String(roleARN),
+			RoleSessionName: aws.String(rName),
+		})
+	}, "AccessDenied")
+
+	if err != nil {
+		t.Fatalf("assuming IAM Role (%s): %s", roleARN, err)
+	}
+}
+
+func testAccAssumeRoleEphemeralConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    effect  = "Allow"
+    actions = ["sts:AssumeRole", "sts:SetSourceIdentity", "sts:TagSession"]
+    principals {
+      type        = "AWS"
+      identifiers = ["arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.test.json
+}
+`, rName)
+}
+
+func testAccAssumeRoleEphemeralConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_sts_assume_role.test"),
+		testAccAssumeRoleEphemeralConfig_base(rName),
+		fmt.Sprintf(`
+ephemeral "aws_sts_assume_role" "test" {
+  role_arn     = aws_iam_role.test.arn
+  session_name = %[1]q
+}
+`, rName))
+}
+
+func testAccAssumeRoleEphemeralConfig_sessionTags(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_sts_assume_role.test"),
+		testAccAssumeRoleEphemeralConfig_base(rName),
+		fmt.Sprintf(`
+ephemeral "aws_sts_assume_role" "test" {
+  role_arn        = aws_iam_role.test.arn
+  duration        = "15m"
+  source_identity = %[1]q
+
+  tags = {
+    team = "example"
+  }
+
+  transitive_tag_keys = ["team"]
+}
+`, rName))
+}
@@ -10,6 +10,13 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
 )
 
+type contextKey int
+
+const (
+	regionKey contextKey = iota
+	resourceTypeKey
+)
+
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -17,5 +24,26 @@ func Context(region string) context.Context {
 
 	ctx = log.Logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionKey, region)
+
 	return ctx
 }
+
+// WithResourceType returns a context for sweeping resources of the specified type.
+func WithResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = log.WithResourceType(ctx, resourceType)
+
+	return context.WithValue(ctx, resourceTypeKey, resourceType)
+}
+
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionKey).(string)
+
+	return v
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeKey).(string)
+
+	return v
+}
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -19,7 +20,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/info"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -118,6 +121,38 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe returns the resource's type and the ID and name given by its attributes.
+// If there is no "id" attribute, the ID is formed from all of the attributes.
+func (sr *sweepResource) Describe(ctx context.Context) info.Resource {
+	v := info.Resource{
+		Region: sr.meta.Region(ctx),
+	}
+
+	if resource, err := sr.factory(ctx); err == nil {
+		v.Type = resourceMetadata(ctx, resource).TypeName
+	}
+
+	parts := make([]string, 0, len(sr.attributes))
+	for _, attr := range sr.attributes {
+		value := fmt.Sprint(attr.value)
+
+		switch attr.path {
+		case names.AttrID:
+			v.ID = value
+		case names.AttrName:
+			v.Name = value
+		}
+
+		parts = append(parts, attr.path+"="+value)
+	}
+
+	if v.ID == "" {
+		v.ID = strings.Join(parts, ",")
+	}
+
+	return v
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package info
+
+import (
+	"time"
+)
+
+// Resource describes a resource found by a sweeper.
+// Details that aren't known are left empty.
+type Resource struct {
+	Type      string            `json:"type"`
+	ID        string            `json:"id"`
+	Region    string            `json:"region"`
+	Name      string            `json:"name,omitempty"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	CreatedAt *time.Time        `json:"created_at,omitempty"`
+}
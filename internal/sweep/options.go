@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/info"
+)
+
+// ResourceInfo describes a resource found by a sweeper.
+type ResourceInfo = info.Resource
+
+// Describer is implemented by Sweepables that can describe the resource that they delete.
+// The SDK and Framework sweepable implementations are Describers.
+type Describer interface {
+	Describe(ctx context.Context) ResourceInfo
+}
+
+// Describe returns a Sweepable that deletes the same resource as s and that adds details to its description.
+// Sweepers use this to report details, such as a resource's creation time, that filters rely on but that s can't describe.
+func Describe(s Sweepable, f func(*ResourceInfo)) Sweepable {
+	return &describedSweepable{
+		Sweepable: s,
+		f:         f,
+	}
+}
+
+type describedSweepable struct {
+	Sweepable
+	f func(*ResourceInfo)
+}
+
+func (ds *describedSweepable) Describe(ctx context.Context) ResourceInfo {
+	v := describe(ctx, ds.Sweepable)
+	ds.f(&v)
+
+	return v
+}
+
+// describe returns the description of the resource deleted by s.
+// The resource type and Region default to those of the context.
+func describe(ctx context.Context, s Sweepable) ResourceInfo {
+	var v ResourceInfo
+
+	if d, ok := s.(Describer); ok {
+		v = d.Describe(ctx)
+	}
+
+	if v.Type == "" {
+		v.Type = resourceTypeFromContext(ctx)
+	}
+	if v.Region == "" {
+		v.Region = regionFromContext(ctx)
+	}
+
+	return v
+}
+
+// Filter selects the resources that are deleted.
+// A resource must meet every criterion that is set. The zero value selects all resources.
+// A resource whose details are unknown doesn't meet a criterion that relies on them.
+type Filter struct {
+	// MinAge selects resources that were created at least this long ago.
+	MinAge time.Duration
+	// NamePrefixes selects resources whose name, or ID if the name is unknown, starts with any of the prefixes.
+	NamePrefixes []string
+	// Tags selects resources that have all of these tags. An empty value matches any value.
+	Tags map[string]string
+}
+
+// IsZero returns whether the filter selects all resources.
+func (f Filter) IsZero() bool {
+	return f.MinAge == 0 && len(f.NamePrefixes) == 0 && len(f.Tags) == 0
+}
+
+// Match returns whether the filter selects the described resource.
+func (f Filter) Match(v ResourceInfo, now time.Time) bool {
+	if f.MinAge > 0 {
+		if v.CreatedAt == nil || now.Sub(*v.CreatedAt) < f.MinAge {
+			return false
+		}
+	}
+
+	if len(f.NamePrefixes) > 0 {
+		name := v.Name
+		if name == "" {
+			name = v.ID
+		}
+
+		if !tfslices.Any(f.NamePrefixes, func(prefix string) bool {
+			return strings.HasPrefix(name, prefix)
+		}) {
+			return false
+		}
+	}
+
+	for key, value := range f.Tags {
+		tagValue, ok := v.Tags[key]
+		if !ok || (value != "" && tagValue != value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Options configure how SweepOrchestrator handles the resources found by sweepers.
+type Options struct {
+	// DryRun reports the resources that would be deleted instead of deleting them.
+	DryRun bool
+	// Filter selects the resources that are deleted, or reported in a dry run.
+	Filter Filter
+	// Output is where dry run reports are written, as one JSON object per resource.
+	// Defaults to standard output.
+	Output io.Writer
+}
+
+// restrictsSweepers returns whether only sweepers that delete resources through SweepOrchestrator may run.
+// RunSweepers fails if any other sweeper would run.
+func (o Options) restrictsSweepers() bool {
+	return o.DryRun || !o.Filter.IsZero()
+}
+
+var (
+	options     Options
+	optionsLock sync.Mutex
+)
+
+// SetOptions sets the options used by all sweepers.
+func SetOptions(opts Options) {
+	optionsLock.Lock()
+	defer optionsLock.Unlock()
+
+	options = opts
+}
+
+func currentOptions() Options {
+	optionsLock.Lock()
+	defer optionsLock.Unlock()
+
+	return options
+}
+
+// filterSweepables returns the Sweepables that delete resources selected by the filter.
+func filterSweepables(ctx context.Context, filter Filter, sweepables []Sweepable) []Sweepable {
+	if filter.IsZero() {
+		return sweepables
+	}
+
+	now := time.Now()
+
+	return tfslices.Filter(sweepables, func(s Sweepable) bool {
+		v := describe(ctx, s)
+
+		if !filter.Match(v, now) {
+			tflog.Debug(ctx, "Skipping resource", map[string]any{
+				"id":          v.ID,
+				"skip_reason": "not selected by filter",
+			})
+			return false
+		}
+
+		return true
+	})
+}
+
+var outputLock sync.Mutex
+
+// reportSweepables writes the description of the resource deleted by each Sweepable to w.
+func reportSweepables(ctx context.Context, w io.Writer, sweepables []Sweepable) error {
+	if w == nil {
+		w = os.Stdout
+	}
+
+	outputLock.Lock()
+	defer outputLock.Unlock()
+
+	enc := json.NewEncoder(w)
+	for _, s := range sweepables {
+		if err := enc.Encode(describe(ctx, s)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ParseTags parses a comma-separated list of key=value tags for a Filter.
+// A key without a value matches any value.
+func ParseTags(s string) map[string]string {
+	tags := make(map[string]string)
+
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+
+		key, value, _ := strings.Cut(v, "=")
+		tags[key] = value
+	}
+
+	return tags
+}
+
+// ParseNamePrefixes parses a comma-separated list of name prefixes for a Filter.
+func ParseNamePrefixes(s string) []string {
+	return tfslices.Filter(strings.Split(s, ","), func(v string) bool {
+		return v != ""
+	})
+}
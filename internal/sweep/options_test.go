@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testSweepable struct {
+	info    sweep.ResourceInfo
+	deleted bool
+}
+
+func (s *testSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	s.deleted = true
+
+	return nil
+}
+
+func (s *testSweepable) Describe(context.Context) sweep.ResourceInfo {
+	return s.info
+}
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	old := now.Add(-48 * time.Hour)
+	recent := now.Add(-1 * time.Hour)
+
+	testCases := map[string]struct {
+		filter   sweep.Filter
+		info     sweep.ResourceInfo
+		expected bool
+	}{
+		"zero": {
+			info:     sweep.ResourceInfo{ID: "vpc-12345678"},
+			expected: true,
+		},
+		"name prefix": {
+			filter:   sweep.Filter{NamePrefixes: []string{"tf-acc-test-", "tf-test-"}},
+			info:     sweep.ResourceInfo{ID: "id", Name: "tf-test-1"},
+			expected: true,
+		},
+		"name prefix no match": {
+			filter: sweep.Filter{NamePrefixes: []string{"tf-acc-test-"}},
+			info:   sweep.ResourceInfo{ID: "tf-acc-test-1", Name: "production"},
+		},
+		"name prefix ID": {
+			filter:   sweep.Filter{NamePrefixes: []string{"tf-acc-test-"}},
+			info:     sweep.ResourceInfo{ID: "tf-acc-test-1"},
+			expected: true,
+		},
+		"tags": {
+			filter:   sweep.Filter{Tags: map[string]string{"Owner": "", "Environment": "test"}},
+			info:     sweep.ResourceInfo{ID: "id", Tags: map[string]string{"Owner": "ci", "Environment": "test", "Name": "example"}},
+			expected: true,
+		},
+		"tags value no match": {
+			filter: sweep.Filter{Tags: map[string]string{"Environment": "test"}},
+			info:   sweep.ResourceInfo{ID: "id", Tags: map[string]string{"Environment": "production"}},
+		},
+		"tags unknown": {
+			filter: sweep.Filter{Tags: map[string]string{"Owner": ""}},
+			info:   sweep.ResourceInfo{ID: "id"},
+		},
+		"min age": {
+			filter:   sweep.Filter{MinAge: 24 * time.Hour},
+			info:     sweep.ResourceInfo{ID: "id", CreatedAt: &old},
+			expected: true,
+		},
+		"min age too recent": {
+			filter: sweep.Filter{MinAge: 24 * time.Hour},
+			info:   sweep.ResourceInfo{ID: "id", CreatedAt: &recent},
+		},
+		"min age unknown": {
+			filter: sweep.Filter{MinAge: 24 * time.Hour},
+			info:   sweep.ResourceInfo{ID: "id"},
+		},
+		"all": {
+			filter:   sweep.Filter{MinAge: 24 * time.Hour, NamePrefixes: []string{"tf-"}, Tags: map[string]string{"Owner": "ci"}},
+			info:     sweep.ResourceInfo{ID: "id", Name: "tf-1", Tags: map[string]string{"Owner": "ci"}, CreatedAt: &old},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.filter.Match(testCase.info, now), testCase.expected; got != want {
+				t.Errorf("Match() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestParseTags(t *testing.T) {
+	t.Parallel()
+
+	got := sweep.ParseTags("Owner=ci, Environment ,Name=a=b,")
+	expected := map[string]string{
+		"Owner":       "ci",
+		"Environment": "",
+		"Name":        "a=b",
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+// TestSweepOrchestratorDryRun sets the package options, so it can't run in parallel with other tests.
+func TestSweepOrchestratorDryRun(t *testing.T) {
+	ctx := sweep.WithResourceType(sweep.Context("us-west-2"), "aws_example_thing")
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	var output bytes.Buffer
+
+	sweep.SetOptions(sweep.Options{
+		DryRun: true,
+		Filter: sweep.Filter{
+			NamePrefixes: []string{"tf-acc-test-"},
+		},
+		Output: &output,
+	})
+	t.Cleanup(func() {
+		sweep.SetOptions(sweep.Options{})
+	})
+
+	selected := &testSweepable{info: sweep.ResourceInfo{ID: "tf-acc-test-1"}}
+	described := &testSweepable{info: sweep.ResourceInfo{ID: "id-2", Type: "aws_other_thing", Region: "us-east-1"}}
+	skipped := &testSweepable{info: sweep.ResourceInfo{ID: "production-1"}}
+	sweepables := []sweep.Sweepable{
+		selected,
+		sweep.Describe(described, func(info *sweep.ResourceInfo) {
+			info.Name = "tf-acc-test-2"
+			info.CreatedAt = &createdAt
+		}),
+		skipped,
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepables); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, s := range []*testSweepable{selected, described, skipped} {
+		if s.deleted {
+			t.Errorf("%s deleted in dry run", s.info.ID)
+		}
+	}
+
+	var got []sweep.ResourceInfo
+	dec := json.NewDecoder(&output)
+	for dec.More() {
+		var v sweep.ResourceInfo
+		if err := dec.Decode(&v); err != nil {
+			t.Fatalf("decoding output: %s", err)
+		}
+		got = append(got, v)
+	}
+
+	expected := []sweep.ResourceInfo{
+		{Type: "aws_example_thing", ID: "tf-acc-test-1", Region: "us-west-2"},
+		{Type: "aws_other_thing", ID: "id-2", Region: "us-east-1", Name: "tf-acc-test-2", CreatedAt: &createdAt},
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"maps"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var (
+	// sweepers is the registry of all sweepers, keyed by name.
+	sweepers map[string]*resource.Sweeper = make(map[string]*resource.Sweeper)
+	// sweeperFns is the set of sweepers that list resources with a SweeperFn and delete them with SweepOrchestrator.
+	sweeperFns map[string]struct{} = make(map[string]struct{})
+	// sweeperServicePackages maps each sweeper to the name of the service package that registered it.
+	sweeperServicePackages map[string]string = make(map[string]string)
+	sweepersLock           sync.Mutex
+)
+
+// AddTestSweepers registers a sweeper.
+// The sweeper is recorded in this package's registry, from which the sweeper dependency graph is built,
+// and is also registered with the Terraform Plugin Testing framework.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweepersLock.Lock()
+	defer sweepersLock.Unlock()
+
+	// Terraform Plugin Testing exits if a sweeper is registered more than once.
+	resource.AddTestSweepers(name, s)
+
+	sweepers[name] = s
+}
+
+// AddTestSweeperFn registers a sweeper that lists resources with a SweeperFn and deletes them with SweepOrchestrator.
+// Only such sweepers can run when a dry run or filter is configured, as other sweepers may delete resources directly.
+func AddTestSweeperFn(name string, s *resource.Sweeper) {
+	AddTestSweepers(name, s)
+
+	sweepersLock.Lock()
+	defer sweepersLock.Unlock()
+
+	sweeperFns[name] = struct{}{}
+}
+
+func isSweeperFn(name string) bool {
+	sweepersLock.Lock()
+	defer sweepersLock.Unlock()
+
+	_, ok := sweeperFns[name]
+
+	return ok
+}
+
+// RegisterServiceSweepers calls f, which registers a service package's sweepers,
+// and records the service package as that of every sweeper registered by f.
+func RegisterServiceSweepers(servicePackageName string, f func()) {
+	registered := Sweepers()
+
+	f()
+
+	sweepersLock.Lock()
+	defer sweepersLock.Unlock()
+
+	for name := range sweepers {
+		if _, ok := registered[name]; !ok {
+			sweeperServicePackages[name] = servicePackageName
+		}
+	}
+}
+
+// SweeperServicePackageName returns the name of the service package that registered the specified sweeper, if known.
+func SweeperServicePackageName(name string) string {
+	sweepersLock.Lock()
+	defer sweepersLock.Unlock()
+
+	return sweeperServicePackages[name]
+}
+
+// Sweepers returns all registered sweepers, keyed by name.
+func Sweepers() map[string]*resource.Sweeper {
+	sweepersLock.Lock()
+	defer sweepersLock.Unlock()
+
+	return maps.Clone(sweepers)
+}
@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/log"
+)
+
+const defaultParallelism = 10
+
+// RunOptions configures RunSweepers.
+type RunOptions struct {
+	// AllowFailures continues running sweepers after a sweeper fails.
+	AllowFailures bool
+	// Include, if set, reports whether a sweeper is run.
+	// Sweepers that aren't run are skipped but still order the sweepers that depend on them.
+	Include func(name string) bool
+	// Parallelism is the maximum number of sweepers that run concurrently.
+	Parallelism int
+	// Progress, if set, is called from a single goroutine as each sweeper starts, completes or is skipped.
+	Progress func(SweeperEvent)
+}
+
+type SweeperStatus string
+
+const (
+	SweeperStarted   SweeperStatus = "started"
+	SweeperSucceeded SweeperStatus = "succeeded"
+	SweeperFailed    SweeperStatus = "failed"
+	SweeperSkipped   SweeperStatus = "skipped"
+)
+
+// SweeperEvent reports the progress of a sweeper.
+type SweeperEvent struct {
+	Time    time.Time     `json:"time"`
+	Region  string        `json:"region"`
+	Sweeper string        `json:"sweeper"`
+	Status  SweeperStatus `json:"status"`
+	Elapsed string        `json:"elapsed,omitempty"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// DependencyGraph returns the dependency graph of the specified sweepers.
+// A sweeper depends on the sweepers named in its Dependencies, which are run before it.
+// Returns an error if a dependency is not one of the specified sweepers or a dependency cycle is detected.
+func DependencyGraph(sweepers map[string]*resource.Sweeper) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	names := slices.Sorted(maps.Keys(sweepers))
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	var errs []error
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if !g.HasNode(dependency) {
+				errs = append(errs, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency))
+				continue
+			}
+
+			if err := g.AddDependency(name, dependency); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, fmt.Errorf("sweepers: %w", err)
+	}
+
+	return g, nil
+}
+
+// FilterSweepers returns the sweepers whose names contain any of the comma-separated values in filter, together with all of their dependencies.
+// Matching is case-insensitive. An empty filter returns all sweepers.
+// Unknown dependencies are ignored here and reported by DependencyGraph.
+func FilterSweepers(filter string, sweepers map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	if filter == "" {
+		return sweepers
+	}
+
+	result := make(map[string]*resource.Sweeper)
+
+	var add func(string)
+	add = func(name string) {
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		s, ok := sweepers[name]
+		if !ok {
+			return
+		}
+
+		result[name] = s
+
+		for _, dependency := range s.Dependencies {
+			add(dependency)
+		}
+	}
+
+	for _, v := range strings.Split(strings.ToLower(filter), ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		for name := range sweepers {
+			if strings.Contains(strings.ToLower(name), v) {
+				add(name)
+			}
+		}
+	}
+
+	return result
+}
+
+// RunSweepers runs the specified sweepers in a Region.
+// Sweepers are started in the dependency graph's overall order and a sweeper is only started once all of its dependencies have completed,
+// so that, for example, subnets are swept before the VPCs that contain them.
+// Independent sweepers run concurrently, up to the configured parallelism.
+// Unless failures are allowed, no further sweepers are started once a sweeper fails.
+func RunSweepers(ctx context.Context, region string, sweepers map[string]*resource.Sweeper, opts RunOptions) error {
+	g, err := DependencyGraph(sweepers)
+	if err != nil {
+		return err
+	}
+
+	order, err := g.OverallOrder()
+	if err != nil {
+		return err
+	}
+
+	// Sweepers that don't delete resources through SweepOrchestrator would ignore a dry run or filter.
+	if currentOptions().restrictsSweepers() {
+		unsupported := tfslices.Filter(order, func(name string) bool {
+			return (opts.Include == nil || opts.Include(name)) && !isSweeperFn(name)
+		})
+
+		if len(unsupported) > 0 {
+			return fmt.Errorf("dry runs and filters are only supported by SweeperFn sweepers, so sweepers (%s) can't be run; select only SweeperFn sweepers", strings.Join(unsupported, ", "))
+		}
+	}
+
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = defaultParallelism
+	}
+
+	// The number of each sweeper's dependencies that have not yet completed.
+	pending := make(map[string]int, len(order))
+	for _, name := range order {
+		dependencies, err := g.DirectDependenciesOf(name)
+		if err != nil {
+			return err
+		}
+		pending[name] = len(dependencies)
+	}
+
+	progress := func(name string, status SweeperStatus, elapsed time.Duration, err error) {
+		if opts.Progress == nil {
+			return
+		}
+
+		event := SweeperEvent{
+			Time:    time.Now(),
+			Region:  region,
+			Sweeper: name,
+			Status:  status,
+		}
+		if elapsed > 0 {
+			event.Elapsed = elapsed.String()
+		}
+		if err != nil {
+			event.Error = err.Error()
+		}
+
+		opts.Progress(event)
+	}
+
+	type result struct {
+		name    string
+		skipped bool
+		elapsed time.Duration
+		err     error
+	}
+	// Buffered so that neither sweepers nor skipped sweepers block on sending their result.
+	results := make(chan result, len(order))
+	started := make(map[string]bool, len(order))
+	var running, completed int
+	var stopped bool
+	var errs []error
+
+	tflog.Info(ctx, "Running sweepers", map[string]any{
+		"sweeper_count": len(order),
+		"parallelism":   parallelism,
+	})
+
+	for completed < len(order) {
+		if !stopped {
+			for _, name := range order {
+				if running >= parallelism {
+					break
+				}
+				if started[name] || pending[name] > 0 {
+					continue
+				}
+
+				started[name] = true
+				running++
+
+				if opts.Include != nil && !opts.Include(name) {
+					results <- result{name: name, skipped: true}
+					continue
+				}
+
+				progress(name, SweeperStarted, 0, nil)
+
+				go func(name string) {
+					start := time.Now()
+					err := runSweeper(ctx, region, name, sweepers[name])
+					results <- result{name: name, elapsed: time.Since(start), err: err}
+				}(name)
+			}
+		}
+
+		if running == 0 {
+			break
+		}
+
+		r := <-results
+		running--
+		completed++
+
+		switch {
+		case r.skipped:
+			progress(r.name, SweeperSkipped, 0, nil)
+		case r.err != nil:
+			progress(r.name, SweeperFailed, r.elapsed, r.err)
+			errs = append(errs, fmt.Errorf("sweeper (%s) for region (%s) failed: %w", r.name, region, r.err))
+
+			if !opts.AllowFailures && !stopped {
+				tflog.Warn(ctx, "Sweeper failed, not starting any further sweepers", map[string]any{
+					"sweeper": r.name,
+				})
+				stopped = true
+			}
+		default:
+			progress(r.name, SweeperSucceeded, r.elapsed, nil)
+		}
+
+		dependents, err := g.DirectDependentsOf(r.name)
+		if err != nil {
+			return err
+		}
+		for _, dependent := range dependents {
+			pending[dependent]--
+		}
+	}
+
+	if n := len(order) - completed; n > 0 {
+		tflog.Warn(ctx, "Sweepers not run", map[string]any{
+			"sweeper_count": n,
+		})
+	}
+
+	return errors.Join(errs...)
+}
+
+func runSweeper(ctx context.Context, region, name string, s *resource.Sweeper) error {
+	ctx = log.WithResourceType(ctx, name)
+
+	tflog.Debug(ctx, "Running sweeper")
+	start := time.Now()
+
+	err := s.F(region)
+
+	fields := map[string]any{
+		"elapsed": time.Since(start).String(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Error(ctx, "Sweeper failed", fields)
+	} else {
+		tflog.Debug(ctx, "Completed sweeper", fields)
+	}
+
+	return err
+}
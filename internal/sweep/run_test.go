@@ -0,0 +1,333 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep_test
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+type testSweeperRecorder struct {
+	mu    sync.Mutex
+	order []string
+}
+
+func (r *testSweeperRecorder) sweeper(name string, err error, dependencies ...string) *resource.Sweeper {
+	return &resource.Sweeper{
+		Name: name,
+		F: func(string) error {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+
+			r.order = append(r.order, name)
+
+			return err
+		},
+		Dependencies: dependencies,
+	}
+}
+
+func (r *testSweeperRecorder) ran(name string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Index(r.order, name)
+}
+
+func TestDependencyGraph(t *testing.T) {
+	t.Parallel()
+
+	var r testSweeperRecorder
+	testCases := map[string]struct {
+		sweepers    map[string]*resource.Sweeper
+		expectError bool
+	}{
+		"no dependencies": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": r.sweeper("aws_a", nil),
+				"aws_b": r.sweeper("aws_b", nil),
+			},
+		},
+		"dependencies": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": r.sweeper("aws_a", nil, "aws_b"),
+				"aws_b": r.sweeper("aws_b", nil, "aws_c"),
+				"aws_c": r.sweeper("aws_c", nil),
+			},
+		},
+		"missing dependency": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": r.sweeper("aws_a", nil, "aws_b"),
+			},
+			expectError: true,
+		},
+		"cycle": {
+			sweepers: map[string]*resource.Sweeper{
+				"aws_a": r.sweeper("aws_a", nil, "aws_b"),
+				"aws_b": r.sweeper("aws_b", nil, "aws_a"),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g, err := sweep.DependencyGraph(testCase.sweepers)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error: %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := g.Len(), len(testCase.sweepers); got != want {
+				t.Errorf("nodes got: %d, expected: %d", got, want)
+			}
+		})
+	}
+}
+
+func TestRegisteredSweepersDependencyGraph(t *testing.T) {
+	t.Parallel()
+
+	if _, err := sweep.DependencyGraph(sweep.Sweepers()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	t.Parallel()
+
+	var r testSweeperRecorder
+	sweepers := map[string]*resource.Sweeper{
+		"aws_network_interface": r.sweeper("aws_network_interface", nil),
+		"aws_subnet":            r.sweeper("aws_subnet", nil, "aws_network_interface"),
+		"aws_vpc":               r.sweeper("aws_vpc", nil, "aws_subnet"),
+		"aws_s3_bucket":         r.sweeper("aws_s3_bucket", nil),
+	}
+
+	testCases := map[string]struct {
+		filter   string
+		expected []string
+	}{
+		"empty": {
+			expected: []string{"aws_network_interface", "aws_s3_bucket", "aws_subnet", "aws_vpc"},
+		},
+		"with dependencies": {
+			filter:   "aws_vpc",
+			expected: []string{"aws_network_interface", "aws_subnet", "aws_vpc"},
+		},
+		"multiple": {
+			filter:   "AWS_S3,subnet",
+			expected: []string{"aws_network_interface", "aws_s3_bucket", "aws_subnet"},
+		},
+		"no match": {
+			filter: "aws_sqs_queue",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := slices.Sorted(maps.Keys(sweep.FilterSweepers(testCase.filter, sweepers)))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestRunSweepers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	errSweep := errors.New("test error")
+
+	t.Run("order", func(t *testing.T) {
+		t.Parallel()
+
+		var r testSweeperRecorder
+		sweepers := map[string]*resource.Sweeper{
+			"aws_network_interface": r.sweeper("aws_network_interface", nil),
+			"aws_subnet":            r.sweeper("aws_subnet", nil, "aws_network_interface"),
+			"aws_vpc":               r.sweeper("aws_vpc", nil, "aws_subnet", "aws_network_interface"),
+			"aws_s3_bucket":         r.sweeper("aws_s3_bucket", nil),
+			"aws_s3_object":         r.sweeper("aws_s3_object", nil),
+		}
+
+		if err := sweep.RunSweepers(ctx, "us-west-2", sweepers, sweep.RunOptions{Parallelism: 3}); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, want := len(r.order), len(sweepers); got != want {
+			t.Fatalf("sweepers run got: %d, expected: %d", got, want)
+		}
+		if !(r.ran("aws_network_interface") < r.ran("aws_subnet") && r.ran("aws_subnet") < r.ran("aws_vpc")) {
+			t.Errorf("sweepers run out of dependency order: %v", r.order)
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		var r testSweeperRecorder
+		sweepers := map[string]*resource.Sweeper{
+			"aws_network_interface": r.sweeper("aws_network_interface", errSweep),
+			"aws_subnet":            r.sweeper("aws_subnet", nil, "aws_network_interface"),
+			"aws_vpc":               r.sweeper("aws_vpc", nil, "aws_subnet"),
+		}
+
+		err := sweep.RunSweepers(ctx, "us-west-2", sweepers, sweep.RunOptions{Parallelism: 1})
+		if !errors.Is(err, errSweep) {
+			t.Fatalf("error got: %v, expected: %s", err, errSweep)
+		}
+
+		if diff := cmp.Diff(r.order, []string{"aws_network_interface"}); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("allow failures", func(t *testing.T) {
+		t.Parallel()
+
+		var r testSweeperRecorder
+		sweepers := map[string]*resource.Sweeper{
+			"aws_network_interface": r.sweeper("aws_network_interface", errSweep),
+			"aws_subnet":            r.sweeper("aws_subnet", nil, "aws_network_interface"),
+			"aws_vpc":               r.sweeper("aws_vpc", errSweep, "aws_subnet"),
+		}
+
+		err := sweep.RunSweepers(ctx, "us-west-2", sweepers, sweep.RunOptions{AllowFailures: true})
+		if !errors.Is(err, errSweep) {
+			t.Fatalf("error got: %v, expected: %s", err, errSweep)
+		}
+
+		if diff := cmp.Diff(r.order, []string{"aws_network_interface", "aws_subnet", "aws_vpc"}); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("include", func(t *testing.T) {
+		t.Parallel()
+
+		var r testSweeperRecorder
+		sweepers := map[string]*resource.Sweeper{
+			"aws_network_interface": r.sweeper("aws_network_interface", nil),
+			"aws_subnet":            r.sweeper("aws_subnet", errSweep, "aws_network_interface"),
+			"aws_vpc":               r.sweeper("aws_vpc", nil, "aws_subnet"),
+		}
+		var events []sweep.SweeperEvent
+		opts := sweep.RunOptions{
+			AllowFailures: true,
+			Include: func(name string) bool {
+				return name != "aws_network_interface"
+			},
+			Progress: func(event sweep.SweeperEvent) {
+				events = append(events, event)
+			},
+		}
+
+		err := sweep.RunSweepers(ctx, "us-west-2", sweepers, opts)
+		if !errors.Is(err, errSweep) {
+			t.Fatalf("error got: %v, expected: %s", err, errSweep)
+		}
+
+		if diff := cmp.Diff(r.order, []string{"aws_subnet", "aws_vpc"}); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+
+		got := tfslices.ApplyToAll(events, func(event sweep.SweeperEvent) string {
+			return event.Sweeper + ":" + string(event.Status)
+		})
+		expected := []string{
+			"aws_network_interface:skipped",
+			"aws_subnet:started",
+			"aws_subnet:failed",
+			"aws_vpc:started",
+			"aws_vpc:succeeded",
+		}
+		if diff := cmp.Diff(got, expected); diff != "" {
+			t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+		}
+	})
+
+	t.Run("missing dependency", func(t *testing.T) {
+		t.Parallel()
+
+		var r testSweeperRecorder
+		sweepers := map[string]*resource.Sweeper{
+			"aws_vpc": r.sweeper("aws_vpc", nil, "aws_subnet"),
+		}
+
+		if err := sweep.RunSweepers(ctx, "us-west-2", sweepers, sweep.RunOptions{}); err == nil {
+			t.Fatal("expected error")
+		}
+
+		if len(r.order) != 0 {
+			t.Errorf("sweepers run: %v", r.order)
+		}
+	})
+}
+
+// TestRunSweepersDryRun sets the package options, so it can't run in parallel with other tests.
+func TestRunSweepersDryRun(t *testing.T) {
+	ctx := context.Background()
+
+	sweep.SetOptions(sweep.Options{
+		DryRun: true,
+	})
+	t.Cleanup(func() {
+		sweep.SetOptions(sweep.Options{})
+	})
+
+	t.Run("unsupported sweeper", func(t *testing.T) {
+		var r testSweeperRecorder
+		sweepers := map[string]*resource.Sweeper{
+			"aws_network_interface": r.sweeper("aws_network_interface", nil),
+			"aws_subnet":            r.sweeper("aws_subnet", nil, "aws_network_interface"),
+		}
+
+		if err := sweep.RunSweepers(ctx, "us-west-2", sweepers, sweep.RunOptions{}); err == nil {
+			t.Fatal("expected error")
+		}
+
+		if len(r.order) != 0 {
+			t.Errorf("sweepers run: %v", r.order)
+		}
+	})
+
+	t.Run("unsupported sweeper not included", func(t *testing.T) {
+		var r testSweeperRecorder
+		sweepers := map[string]*resource.Sweeper{
+			"aws_network_interface": r.sweeper("aws_network_interface", nil),
+		}
+		opts := sweep.RunOptions{
+			Include: func(name string) bool {
+				return false
+			},
+		}
+
+		if err := sweep.RunSweepers(ctx, "us-west-2", sweepers, opts); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(r.order) != 0 {
+			t.Errorf("sweepers run: %v", r.order)
+		}
+	})
+}
@@ -17,7 +17,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/internal/info"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type sweepResource struct {
@@ -89,6 +91,33 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe returns the resource's ID and, if the sweeper set them in the resource data, its name and tags.
+func (sr *sweepResource) Describe(ctx context.Context) info.Resource {
+	v := info.Resource{
+		ID:     sr.d.Id(),
+		Region: sr.meta.Region(ctx),
+	}
+
+	schemaMap := sr.resource.SchemaMap()
+
+	if _, ok := schemaMap[names.AttrName]; ok {
+		if name, ok := sr.d.Get(names.AttrName).(string); ok {
+			v.Name = name
+		}
+	}
+
+	if _, ok := schemaMap[names.AttrTags]; ok {
+		if tags, ok := sr.d.Get(names.AttrTags).(map[string]any); ok && len(tags) > 0 {
+			v.Tags = make(map[string]string, len(tags))
+			for key, value := range tags {
+				v.Tags[key], _ = value.(string)
+			}
+		}
+	}
+
+	return v
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -31,10 +32,17 @@ var ServicePackages []conns.ServicePackage
 
 // sweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
-var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+// Sweepers run concurrently, so access is guarded by sweeperClientsLock.
+var (
+	sweeperClients     map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
+	sweeperClientsLock sync.Mutex
+)
 
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -106,11 +114,21 @@ type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// SweepOrchestrator concurrently deletes the resources selected by the configured filter.
+// In a dry run the resources are reported and not deleted.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	opts := currentOptions()
+
+	sweepables = filterSweepables(ctx, opts.Filter, sweepables)
+
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	if opts.DryRun {
+		return reportSweepables(ctx, opts.Output, sweepables)
+	}
+
 	var g multierror.Group
 
 	for _, sweepable := range sweepables {
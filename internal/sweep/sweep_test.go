@@ -5,12 +5,25 @@ package sweep_test
 
 import (
 	"context"
+	"errors"
+	"flag"
+	"log"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
+var (
+	flagSweepDryRun      = flag.Bool("sweep-dry-run", false, "Report the resources that Sweepers would delete, as JSON, without deleting them")
+	flagSweepMinAge      = flag.Duration("sweep-min-age", 0, "Only sweep resources created at least this long ago")
+	flagSweepNamePrefix  = flag.String("sweep-name-prefix", "", "Comma separated list of name prefixes of resources to sweep")
+	flagSweepParallelism = flag.Int("sweep-parallelism", 10, "Maximum number of Sweepers to run concurrently in each Region")
+	flagSweepTag         = flag.String("sweep-tag", "", "Comma separated list of key=value tags of resources to sweep")
+)
+
 func TestMain(m *testing.M) {
 	ctx := context.Background()
 
@@ -18,5 +31,56 @@ func TestMain(m *testing.M) {
 
 	registerSweepers()
 
+	// The -sweep, -sweep-run and -sweep-allow-failures flags are defined by the Terraform Plugin Testing framework.
+	// When sweeping, run the sweepers in dependency order here instead of in the framework.
+	flag.Parse()
+	if regions := flagValue("sweep"); regions != "" {
+		sweep.SetOptions(sweep.Options{
+			DryRun: *flagSweepDryRun,
+			Filter: sweep.Filter{
+				MinAge:       *flagSweepMinAge,
+				NamePrefixes: sweep.ParseNamePrefixes(*flagSweepNamePrefix),
+				Tags:         sweep.ParseTags(*flagSweepTag),
+			},
+		})
+
+		if err := runSweepers(strings.Split(regions, ","), flagValue("sweep-run"), flagValue("sweep-allow-failures") == "true"); err != nil {
+			log.Printf("[ERROR] %s", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	resource.TestMain(m)
 }
+
+func runSweepers(regions []string, filter string, allowFailures bool) error {
+	sweepers := sweep.FilterSweepers(filter, sweep.Sweepers())
+	opts := sweep.RunOptions{
+		AllowFailures: allowFailures,
+		Parallelism:   *flagSweepParallelism,
+	}
+
+	var errs []error
+	for _, region := range regions {
+		region = strings.TrimSpace(region)
+		ctx := sweep.Context(region)
+
+		if err := sweep.RunSweepers(ctx, region, sweepers, opts); err != nil {
+			if !allowFailures {
+				return err
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// The sweeper command runs the acceptance test sweepers outside of the acceptance test harness.
+//
+// Usage:
+//
+//	go run ./internal/sweep/sweeper -regions=us-west-2,us-east-1 [flags]
+//
+// Progress is written to standard output as one JSON object per sweeper event.
+// In a dry run the resources that would be deleted are also written to standard output, as one JSON object per resource.
+// The exit code is 1 if any sweeper fails and 2 if the command is used incorrectly.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+const (
+	exitCodeFailure = 1
+	exitCodeUsage   = 2
+)
+
+type options struct {
+	allowFailures bool
+	dryRun        bool
+	filter        sweep.Filter
+	parallelism   int
+	regions       []string
+	resourceTypes []string
+	services      []string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	opts, err := parseFlags(args, stderr)
+	if err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(stderr, err)
+		}
+		return exitCodeUsage
+	}
+
+	ctx := context.Background()
+
+	sweep.ServicePackages = servicePackages(ctx)
+
+	registerSweepers()
+
+	sweepers := sweep.Sweepers()
+	for _, resourceType := range opts.resourceTypes {
+		if _, ok := sweepers[resourceType]; !ok {
+			fmt.Fprintf(stderr, "no sweeper for resource type %q\n", resourceType)
+			return exitCodeUsage
+		}
+	}
+
+	output := &jsonLinesWriter{w: stdout}
+
+	sweep.SetOptions(sweep.Options{
+		DryRun: opts.dryRun,
+		Filter: opts.filter,
+		Output: output,
+	})
+
+	runOpts := sweep.RunOptions{
+		AllowFailures: opts.allowFailures,
+		Include:       opts.include,
+		Parallelism:   opts.parallelism,
+		Progress: func(event sweep.SweeperEvent) {
+			output.encode(event)
+		},
+	}
+
+	var failed bool
+	for _, region := range opts.regions {
+		if err := sweep.RunSweepers(sweep.Context(region), region, sweepers, runOpts); err != nil {
+			fmt.Fprintln(stderr, err)
+			failed = true
+
+			if !opts.allowFailures {
+				break
+			}
+		}
+	}
+
+	if failed {
+		return exitCodeFailure
+	}
+
+	return 0
+}
+
+func parseFlags(args []string, stderr io.Writer) (options, error) {
+	var opts options
+	var namePrefixes, regions, resourceTypes, services, tags string
+
+	fs := flag.NewFlagSet("sweeper", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.BoolVar(&opts.allowFailures, "allow-failures", false, "continue running sweepers after a sweeper fails")
+	fs.BoolVar(&opts.dryRun, "dry-run", false, "report the resources that sweepers would delete without deleting them")
+	fs.DurationVar(&opts.filter.MinAge, "min-age", 0, "only sweep resources created at least this long ago")
+	fs.StringVar(&namePrefixes, "name-prefix", "", "comma-separated list of name prefixes of resources to sweep")
+	fs.IntVar(&opts.parallelism, "parallelism", 10, "maximum number of sweepers to run concurrently in each region")
+	fs.StringVar(&regions, "regions", "", "comma-separated list of regions to sweep (required)")
+	fs.StringVar(&resourceTypes, "resource-types", "", "comma-separated list of resource types whose sweepers may run (default all)")
+	fs.StringVar(&services, "services", "", "comma-separated list of service packages whose sweepers may run (default all)")
+	fs.StringVar(&tags, "tag", "", "comma-separated list of key=value tags of resources to sweep")
+
+	if err := fs.Parse(args); err != nil {
+		return opts, err
+	}
+
+	if fs.NArg() > 0 {
+		return opts, fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+
+	opts.regions = splitList(regions)
+	if len(opts.regions) == 0 {
+		return opts, fmt.Errorf("-regions is required")
+	}
+
+	opts.filter.NamePrefixes = sweep.ParseNamePrefixes(namePrefixes)
+	opts.filter.Tags = sweep.ParseTags(tags)
+	opts.resourceTypes = splitList(resourceTypes)
+	opts.services = splitList(services)
+
+	return opts, nil
+}
+
+// include reports whether the specified sweeper may run.
+// Sweepers outside the allowlists are skipped, even if an allowed sweeper depends on them.
+func (opts options) include(name string) bool {
+	if len(opts.resourceTypes) > 0 && !slices.Contains(opts.resourceTypes, name) {
+		return false
+	}
+
+	if len(opts.services) > 0 && !slices.Contains(opts.services, sweep.SweeperServicePackageName(name)) {
+		return false
+	}
+
+	return true
+}
+
+func splitList(s string) []string {
+	var v []string
+
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			v = append(v, part)
+		}
+	}
+
+	return v
+}
+
+// jsonLinesWriter serializes writes of JSON lines from concurrently running sweepers.
+type jsonLinesWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (w *jsonLinesWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.w.Write(p)
+}
+
+func (w *jsonLinesWriter) encode(v any) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+
+	// Progress output is best effort.
+	_, _ = w.Write(append(b, '\n'))
+}
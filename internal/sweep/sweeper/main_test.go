@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseFlags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		args          []string
+		expectError   bool
+		regions       []string
+		resourceTypes []string
+		services      []string
+		minAge        time.Duration
+	}{
+		"no regions": {
+			args:        []string{"-services=ec2"},
+			expectError: true,
+		},
+		"unexpected argument": {
+			args:        []string{"-regions=us-west-2", "ec2"},
+			expectError: true,
+		},
+		"regions": {
+			args:    []string{"-regions=us-west-2, us-east-1,"},
+			regions: []string{"us-west-2", "us-east-1"},
+		},
+		"allowlists": {
+			args:          []string{"-regions=us-west-2", "-services=ec2,s3", "-resource-types=aws_vpc", "-min-age=24h"},
+			regions:       []string{"us-west-2"},
+			resourceTypes: []string{"aws_vpc"},
+			services:      []string{"ec2", "s3"},
+			minAge:        24 * time.Hour,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			opts, err := parseFlags(testCase.args, io.Discard)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("error = %v, expected error: %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(opts.regions, testCase.regions); diff != "" {
+				t.Errorf("unexpected regions diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(opts.resourceTypes, testCase.resourceTypes); diff != "" {
+				t.Errorf("unexpected resource types diff (+wanted, -got): %s", diff)
+			}
+			if diff := cmp.Diff(opts.services, testCase.services); diff != "" {
+				t.Errorf("unexpected services diff (+wanted, -got): %s", diff)
+			}
+			if got, want := opts.filter.MinAge, testCase.minAge; got != want {
+				t.Errorf("min age got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestRunUsage(t *testing.T) {
+	t.Parallel()
+
+	if got, want := run([]string{"-regions=us-west-2", "-resource-types=aws_not_a_resource"}, io.Discard, io.Discard), exitCodeUsage; got != want {
+		t.Errorf("exit code got: %d, expected: %d", got, want)
+	}
+}
@@ -4,9 +4,15 @@
 package tags
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	dataschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // Terraform Plugin Framework variants of tags schemas.
@@ -35,6 +41,26 @@ func TagsAttributeRequired() schema.Attribute {
 	}
 }
 
+func DefaultTagsExcludeAttribute() schema.Attribute {
+	return schema.SetAttribute{
+		CustomType:  fwtypes.SetOfStringType,
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.Set{
+			setvalidator.ConflictsWith(path.MatchRoot(names.AttrSkipDefaultTags)),
+		},
+	}
+}
+
+func SkipDefaultTagsAttribute() schema.Attribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Validators: []validator.Bool{
+			boolvalidator.ConflictsWith(path.MatchRoot(names.AttrDefaultTagsExclude)),
+		},
+	}
+}
+
 var (
 	Unknown = types.MapUnknown(types.StringType)
 )
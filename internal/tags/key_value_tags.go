@@ -86,6 +86,35 @@ func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
 	return dc.Tags.Merge(tags)
 }
 
+// ForResource returns the DefaultConfig to apply to a single resource.
+// If skip is true, no default tags apply. Otherwise any default tags with the
+// specified keys are excluded.
+func (dc *DefaultConfig) ForResource(skip bool, excludeKeys []string) *DefaultConfig {
+	if dc == nil || dc.Tags == nil {
+		return dc
+	}
+
+	if skip {
+		return nil
+	}
+
+	if len(excludeKeys) == 0 {
+		return dc
+	}
+
+	tags := make(KeyValueTags)
+
+	for k, v := range dc.Tags {
+		if !slices.Contains(excludeKeys, k) {
+			tags[k] = v
+		}
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
+
 // TagsEqual returns true if the given configuration's Tags
 // are equal to those passed in as an argument;
 // otherwise returns false
@@ -2262,6 +2262,80 @@ func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
 	}
 }
 
+func TestDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		skip          bool
+		excludeKeys   []string
+		wantNil       bool
+		want          map[string]string
+	}{
+		{
+			name:    "nil config",
+			skip:    true,
+			wantNil: true,
+		},
+		{
+			name: "no opt out",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "skip",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			skip:    true,
+			wantNil: true,
+		},
+		{
+			name: "exclude keys",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+					"key3": "value3",
+				}),
+			},
+			excludeKeys: []string{"key1", "key3", "key4"},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.skip, testCase.excludeKeys)
+
+			if testCase.wantNil {
+				if got != nil {
+					t.Fatalf("expected nil, got %v", got.Tags.Map())
+				}
+				return
+			}
+
+			testKeyValueTagsVerifyMap(t, got.Tags.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsURLEncode(t *testing.T) {
 	t.Parallel()
 
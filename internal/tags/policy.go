@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	TagPolicyValueCaseLower = "lower"
+	TagPolicyValueCaseUpper = "upper"
+)
+
+func TagPolicyValueCase_Values() []string {
+	return []string{
+		TagPolicyValueCaseLower,
+		TagPolicyValueCaseUpper,
+	}
+}
+
+// TagPolicy contains rules that the tags of resources must satisfy.
+// The rules are checked against a resource's tags merged with any default tags.
+type TagPolicy struct {
+	// ResourceTypes are the resource types, e.g. "aws_s3_bucket", to which the policy applies.
+	// If empty, the policy applies to all resources that support tagging.
+	ResourceTypes []string
+	Rules         []TagPolicyRule
+}
+
+// TagPolicyRule contains the rules for one tag key.
+type TagPolicyRule struct {
+	Key string
+	// Required is whether the tag must be present.
+	Required bool
+	// AllowedValues and AllowedValuePatterns, if either is non-empty, list the values that the tag can have.
+	AllowedValues        []string
+	AllowedValuePatterns []*regexp.Regexp
+	// EnforceKeyCase is whether the tag key must match the case of Key. Otherwise keys match case-insensitively.
+	EnforceKeyCase bool
+	// ValueCase, if set, is the case that the tag value must have.
+	ValueCase string
+}
+
+// TagPolicyViolation is a resource's failure to satisfy a tag policy rule.
+type TagPolicyViolation struct {
+	TypeName string
+	// Key is the offending tag key, as it appears in the resource's tags if present.
+	Key    string
+	Detail string
+}
+
+func (v TagPolicyViolation) Error() string {
+	return fmt.Sprintf("tag policy violation (%s): tag %q %s", v.TypeName, v.Key, v.Detail)
+}
+
+// AppliesTo returns whether the policy applies to the specified resource type.
+func (p *TagPolicy) AppliesTo(typeName string) bool {
+	if p == nil || len(p.Rules) == 0 {
+		return false
+	}
+
+	return len(p.ResourceTypes) == 0 || slices.Contains(p.ResourceTypes, typeName)
+}
+
+// Check returns the violations of the policy by the specified resource type's tags.
+func (p *TagPolicy) Check(typeName string, tags KeyValueTags) []TagPolicyViolation {
+	if !p.AppliesTo(typeName) {
+		return nil
+	}
+
+	var violations []TagPolicyViolation
+
+	for _, rule := range p.Rules {
+		violation := func(key, format string, a ...any) {
+			violations = append(violations, TagPolicyViolation{
+				TypeName: typeName,
+				Key:      key,
+				Detail:   fmt.Sprintf(format, a...),
+			})
+		}
+
+		key, ok := rule.Key, tags.KeyExists(rule.Key)
+		if !ok {
+			// Find a key that matches ignoring case.
+			for k := range tags {
+				if strings.EqualFold(k, rule.Key) {
+					key, ok = k, true
+					break
+				}
+			}
+
+			if ok && rule.EnforceKeyCase {
+				violation(key, "must be written as %q", rule.Key)
+				continue
+			}
+		}
+
+		if !ok {
+			if rule.Required {
+				violation(rule.Key, "is required")
+			}
+			continue
+		}
+
+		value := tags.KeyValue(key)
+		if value == nil {
+			continue
+		}
+
+		switch rule.ValueCase {
+		case TagPolicyValueCaseLower:
+			if *value != strings.ToLower(*value) {
+				violation(key, "value %q must be lower case", *value)
+				continue
+			}
+		case TagPolicyValueCaseUpper:
+			if *value != strings.ToUpper(*value) {
+				violation(key, "value %q must be upper case", *value)
+				continue
+			}
+		}
+
+		if len(rule.AllowedValues) == 0 && len(rule.AllowedValuePatterns) == 0 {
+			continue
+		}
+
+		if slices.Contains(rule.AllowedValues, *value) || slices.ContainsFunc(rule.AllowedValuePatterns, func(re *regexp.Regexp) bool {
+			return re.MatchString(*value)
+		}) {
+			continue
+		}
+
+		violation(key, "value %q is not allowed", *value)
+	}
+
+	return violations
+}
+
+// ParseOrganizationsTagPolicy returns the tag policy rules from an AWS Organizations tag policy JSON document.
+// Each tag's key capitalization is enforced and its values, which may end with a `*` wildcard, are allowed.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html.
+// The `enforced_for` resource types are AWS resource types and are ignored.
+func ParseOrganizationsTagPolicy(document string) ([]TagPolicyRule, error) {
+	type assign[T any] struct {
+		Assign T `json:"@@assign"`
+	}
+	var policy struct {
+		Tags map[string]struct {
+			TagKey   *assign[string]   `json:"tag_key"`
+			TagValue *assign[[]string] `json:"tag_value"`
+		} `json:"tags"`
+	}
+
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		return nil, fmt.Errorf("parsing AWS Organizations tag policy: %w", err)
+	}
+
+	var rules []TagPolicyRule
+
+	for name, tag := range policy.Tags {
+		rule := TagPolicyRule{
+			Key: name,
+		}
+
+		if tag.TagKey != nil && tag.TagKey.Assign != "" {
+			rule.Key = tag.TagKey.Assign
+			rule.EnforceKeyCase = true
+		}
+
+		if tag.TagValue != nil {
+			for _, v := range tag.TagValue.Assign {
+				if prefix, ok := strings.CutSuffix(v, "*"); ok {
+					rule.AllowedValuePatterns = append(rule.AllowedValuePatterns, regexp.MustCompile(`^`+regexp.QuoteMeta(prefix)))
+				} else {
+					rule.AllowedValues = append(rule.AllowedValues, v)
+				}
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	slices.SortFunc(rules, func(a, b TagPolicyRule) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return rules, nil
+}
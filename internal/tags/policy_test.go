@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTagPolicyCheck(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &TagPolicy{
+		ResourceTypes: []string{"aws_s3_bucket", "aws_vpc"},
+		Rules: []TagPolicyRule{
+			{
+				Key:            "CostCenter",
+				Required:       true,
+				AllowedValues:  []string{"100", "200"},
+				EnforceKeyCase: true,
+			},
+			{
+				Key:                  "Environment",
+				AllowedValuePatterns: []*regexp.Regexp{regexp.MustCompile(`^(dev|prod)-`)},
+				ValueCase:            TagPolicyValueCaseLower,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		typeName string
+		tags     map[string]string
+		want     []TagPolicyViolation
+	}{
+		{
+			name:     "compliant",
+			typeName: "aws_s3_bucket",
+			tags:     map[string]string{"CostCenter": "100", "Environment": "dev-1"},
+		},
+		{
+			name:     "resource type not covered",
+			typeName: "aws_instance",
+			tags:     map[string]string{},
+		},
+		{
+			name:     "required key missing",
+			typeName: "aws_s3_bucket",
+			tags:     map[string]string{"Environment": "prod-1"},
+			want: []TagPolicyViolation{
+				{TypeName: "aws_s3_bucket", Key: "CostCenter", Detail: "is required"},
+			},
+		},
+		{
+			name:     "key case",
+			typeName: "aws_vpc",
+			tags:     map[string]string{"costcenter": "100", "environment": "dev-1"},
+			want: []TagPolicyViolation{
+				{TypeName: "aws_vpc", Key: "costcenter", Detail: `must be written as "CostCenter"`},
+			},
+		},
+		{
+			name:     "values not allowed",
+			typeName: "aws_vpc",
+			tags:     map[string]string{"CostCenter": "300", "Environment": "test-1"},
+			want: []TagPolicyViolation{
+				{TypeName: "aws_vpc", Key: "CostCenter", Detail: `value "300" is not allowed`},
+				{TypeName: "aws_vpc", Key: "Environment", Detail: `value "test-1" is not allowed`},
+			},
+		},
+		{
+			name:     "value case",
+			typeName: "aws_vpc",
+			tags:     map[string]string{"CostCenter": "200", "Environment": "Dev-1"},
+			want: []TagPolicyViolation{
+				{TypeName: "aws_vpc", Key: "Environment", Detail: `value "Dev-1" must be lower case`},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := policy.Check(testCase.typeName, New(ctx, testCase.tags))
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseOrganizationsTagPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	document := `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter"},
+      "tag_value": {"@@assign": ["100", "200*"]},
+      "enforced_for": {"@@assign": ["ec2:instance"]}
+    },
+    "owner": {}
+  }
+}`
+
+	rules, err := ParseOrganizationsTagPolicy(document)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(rules), 2; got != want {
+		t.Fatalf("rules got: %d, expected: %d", got, want)
+	}
+
+	policy := &TagPolicy{Rules: rules}
+
+	if got := policy.Check("aws_instance", New(ctx, map[string]string{"CostCenter": "2001", "owner": "me"})); len(got) != 0 {
+		t.Errorf("unexpected violations: %v", got)
+	}
+	if got := policy.Check("aws_instance", New(ctx, map[string]string{"costcenter": "100"})); len(got) != 1 {
+		t.Errorf("violations got: %v, expected one", got)
+	}
+	if got := policy.Check("aws_instance", New(ctx, map[string]string{"CostCenter": "300"})); len(got) != 1 {
+		t.Errorf("violations got: %v, expected one", got)
+	}
+
+	if _, err := ParseOrganizationsTagPolicy("{"); err == nil {
+		t.Error("expected error parsing invalid document")
+	}
+}
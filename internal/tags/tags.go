@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // TagsSchema returns the schema to use for configurable resource tags.
@@ -37,3 +38,39 @@ var TagsSchemaForceNew = sync.OnceValue(func() *schema.Schema {
 		Elem:     &schema.Schema{Type: schema.TypeString},
 	}
 })
+
+// DefaultTagsExcludeSchema returns the schema to use for the keys of provider default tags that do not apply to a resource.
+var DefaultTagsExcludeSchema = sync.OnceValue(func() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeSet,
+		Optional:      true,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		ConflictsWith: []string{names.AttrSkipDefaultTags},
+	}
+})
+
+// SkipDefaultTagsSchema returns the schema to use for opting a resource out of provider default tags.
+var SkipDefaultTagsSchema = sync.OnceValue(func() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeBool,
+		Optional:      true,
+		ConflictsWith: []string{names.AttrDefaultTagsExclude},
+	}
+})
+
+// ResourceDefaultConfig returns the DefaultConfig to apply to the resource,
+// honoring any `skip_default_tags` and `default_tags_exclude` attributes defined in its schema.
+func ResourceDefaultConfig(dc *DefaultConfig, d interface{ Get(string) any }) *DefaultConfig {
+	skip, _ := d.Get(names.AttrSkipDefaultTags).(bool)
+
+	var excludeKeys []string
+	if v, ok := d.Get(names.AttrDefaultTagsExclude).(*schema.Set); ok {
+		for _, v := range v.List() {
+			if v, ok := v.(string); ok {
+				excludeKeys = append(excludeKeys, v)
+			}
+		}
+	}
+
+	return dc.ForResource(skip, excludeKeys)
+}
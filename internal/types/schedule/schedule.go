@@ -0,0 +1,501 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule expressions as used by Amazon EventBridge rules and EventBridge Scheduler schedules.
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-scheduled-rule-pattern.html
+// https://docs.aws.amazon.com/scheduler/latest/UserGuide/schedule-types.html
+
+const (
+	atTimeLayout = "2006-01-02T15:04:05"
+
+	minYear = 1970
+	maxYear = 2199
+)
+
+var ErrSyntax = errors.New("invalid syntax")
+
+// Expression is a parsed schedule expression.
+type Expression interface {
+	// Next returns the first fire time strictly after t, evaluated in t's location.
+	// The second return value is false if there are no further fire times.
+	Next(t time.Time) (time.Time, bool)
+	fmt.Stringer
+}
+
+// Parse parses a cron(...), rate(...) or at(...) schedule expression.
+func Parse(s string) (Expression, error) {
+	switch {
+	case strings.HasPrefix(s, "cron(") && strings.HasSuffix(s, ")"):
+		return ParseCron(s)
+	case strings.HasPrefix(s, "rate(") && strings.HasSuffix(s, ")"):
+		return ParseRate(s)
+	case strings.HasPrefix(s, "at(") && strings.HasSuffix(s, ")"):
+		return ParseAt(s)
+	default:
+		return nil, fmt.Errorf("%q: %w: must be cron(...), rate(...) or at(...)", s, ErrSyntax)
+	}
+}
+
+// At is a one-time schedule, at(yyyy-mm-ddThh:mm:ss).
+type At struct {
+	year                      int
+	month                     time.Month
+	day, hour, minute, second int
+}
+
+func ParseAt(s string) (At, error) {
+	v, ok := unwrap(s, "at")
+	if !ok {
+		return At{}, fmt.Errorf("%q: %w: must be at(yyyy-mm-ddThh:mm:ss)", s, ErrSyntax)
+	}
+
+	t, err := time.Parse(atTimeLayout, v)
+	if err != nil {
+		return At{}, fmt.Errorf("%q: %w: must be at(yyyy-mm-ddThh:mm:ss)", s, ErrSyntax)
+	}
+
+	return At{
+		year:   t.Year(),
+		month:  t.Month(),
+		day:    t.Day(),
+		hour:   t.Hour(),
+		minute: t.Minute(),
+		second: t.Second(),
+	}, nil
+}
+
+func (a At) Next(t time.Time) (time.Time, bool) {
+	v := time.Date(a.year, a.month, a.day, a.hour, a.minute, a.second, 0, t.Location())
+	if !v.After(t) {
+		return time.Time{}, false
+	}
+
+	return v, true
+}
+
+func (a At) String() string {
+	return fmt.Sprintf("at(%04d-%02d-%02dT%02d:%02d:%02d)", a.year, a.month, a.day, a.hour, a.minute, a.second)
+}
+
+// Rate is a recurring schedule, rate(value unit).
+// Fire times are relative to the time the schedule is created, so Next
+// treats the time passed to it as that starting point.
+type Rate struct {
+	value int
+	unit  string
+}
+
+func ParseRate(s string) (Rate, error) {
+	v, ok := unwrap(s, "rate")
+	if !ok {
+		return Rate{}, fmt.Errorf("%q: %w: must be rate(value unit)", s, ErrSyntax)
+	}
+
+	parts := strings.Fields(v)
+	if len(parts) != 2 {
+		return Rate{}, fmt.Errorf("%q: %w: must be rate(value unit)", s, ErrSyntax)
+	}
+
+	value, err := strconv.Atoi(parts[0])
+	if err != nil || value < 1 || parts[0] != strconv.Itoa(value) {
+		return Rate{}, fmt.Errorf("%q: %w: value must be a positive integer", s, ErrSyntax)
+	}
+
+	unit := parts[1]
+	switch unit {
+	case "minute", "hour", "day":
+		if value != 1 {
+			return Rate{}, fmt.Errorf("%q: %w: unit must be %q for values greater than 1", s, ErrSyntax, unit+"s")
+		}
+	case "minutes", "hours", "days":
+		if value == 1 {
+			return Rate{}, fmt.Errorf("%q: %w: unit must be %q for a value of 1", s, ErrSyntax, strings.TrimSuffix(unit, "s"))
+		}
+	default:
+		return Rate{}, fmt.Errorf("%q: %w: unit must be one of minute(s), hour(s) or day(s)", s, ErrSyntax)
+	}
+
+	return Rate{value: value, unit: strings.TrimSuffix(unit, "s")}, nil
+}
+
+func (r Rate) Duration() time.Duration {
+	switch r.unit {
+	case "hour":
+		return time.Duration(r.value) * time.Hour
+	case "day":
+		return time.Duration(r.value) * 24 * time.Hour
+	default:
+		return time.Duration(r.value) * time.Minute
+	}
+}
+
+func (r Rate) Next(t time.Time) (time.Time, bool) {
+	return t.Add(r.Duration()), true
+}
+
+func (r Rate) String() string {
+	unit := r.unit
+	if r.value != 1 {
+		unit += "s"
+	}
+
+	return fmt.Sprintf("rate(%d %s)", r.value, unit)
+}
+
+// Cron is a recurring schedule, cron(minutes hours day-of-month month day-of-week year).
+type Cron struct {
+	expr string
+
+	minutes []bool // 0-59
+	hours   []bool // 0-23
+	months  []bool // 1-12
+	years   []bool // minYear-maxYear
+
+	dom cronDayOfMonth
+	dow cronDayOfWeek
+}
+
+type cronDayOfMonth struct {
+	any         bool   // ?
+	days        []bool // 1-31
+	last        bool   // L
+	lastWeekday bool   // LW
+	weekday     int    // nW
+}
+
+type cronDayOfWeek struct {
+	any  bool   // ?
+	days []bool // 1-7, SUN-SAT
+	last int    // nL
+	nth  int    // n#k
+	k    int
+}
+
+var (
+	monthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	dayOfWeekNames = map[string]int{
+		"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7,
+	}
+)
+
+func ParseCron(s string) (Cron, error) {
+	v, ok := unwrap(s, "cron")
+	if !ok {
+		return Cron{}, fmt.Errorf("%q: %w: must be cron(minutes hours day-of-month month day-of-week year)", s, ErrSyntax)
+	}
+
+	fields := strings.Fields(v)
+	if len(fields) != 6 {
+		return Cron{}, fmt.Errorf("%q: %w: must have 6 fields (minutes hours day-of-month month day-of-week year), got %d", s, ErrSyntax, len(fields))
+	}
+
+	c := Cron{expr: s}
+	var err error
+
+	if c.minutes, err = parseCronField(fields[0], 0, 59, nil); err != nil {
+		return Cron{}, fmt.Errorf("%q: minutes: %w", s, err)
+	}
+	if c.hours, err = parseCronField(fields[1], 0, 23, nil); err != nil {
+		return Cron{}, fmt.Errorf("%q: hours: %w", s, err)
+	}
+	if c.dom, err = parseCronDayOfMonth(fields[2]); err != nil {
+		return Cron{}, fmt.Errorf("%q: day-of-month: %w", s, err)
+	}
+	if c.months, err = parseCronField(fields[3], 1, 12, monthNames); err != nil {
+		return Cron{}, fmt.Errorf("%q: month: %w", s, err)
+	}
+	if c.dow, err = parseCronDayOfWeek(fields[4]); err != nil {
+		return Cron{}, fmt.Errorf("%q: day-of-week: %w", s, err)
+	}
+	if c.years, err = parseCronField(fields[5], minYear, maxYear, nil); err != nil {
+		return Cron{}, fmt.Errorf("%q: year: %w", s, err)
+	}
+
+	// You can't specify the day-of-month and day-of-week fields in the same cron expression.
+	// If you specify a value or a * in one of the fields, you must use a ? in the other.
+	if c.dom.any == c.dow.any {
+		return Cron{}, fmt.Errorf("%q: %w: exactly one of day-of-month or day-of-week must be ?", s, ErrSyntax)
+	}
+
+	return c, nil
+}
+
+func (c Cron) String() string {
+	return c.expr
+}
+
+func (c Cron) Next(t time.Time) (time.Time, bool) {
+	loc := t.Location()
+	// Cron expressions have minute granularity.
+	start := t.Truncate(time.Minute)
+
+	for year := max(start.Year(), minYear); year <= maxYear; year++ {
+		if !c.years[year] {
+			continue
+		}
+
+		for month := time.January; month <= time.December; month++ {
+			if !c.months[month] {
+				continue
+			}
+			if year == start.Year() && month < start.Month() {
+				continue
+			}
+
+			days := daysIn(year, month)
+			for day := 1; day <= days; day++ {
+				if year == start.Year() && month == start.Month() && day < start.Day() {
+					continue
+				}
+				if !c.matchesDay(year, month, day) {
+					continue
+				}
+
+				for hour := range 24 {
+					if !c.hours[hour] {
+						continue
+					}
+
+					for minute := range 60 {
+						if !c.minutes[minute] {
+							continue
+						}
+
+						v := time.Date(year, month, day, hour, minute, 0, 0, loc)
+						// Skip wall clock times that don't exist, e.g. during a daylight saving time transition.
+						if v.Hour() != hour || v.Minute() != minute {
+							continue
+						}
+						if v.After(t) {
+							return v, true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (c Cron) matchesDay(year int, month time.Month, day int) bool {
+	days := daysIn(year, month)
+	weekday := int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday()) + 1 // SUN=1
+
+	if !c.dom.any {
+		switch {
+		case c.dom.last:
+			return day == days
+		case c.dom.lastWeekday:
+			return day == nearestWeekday(year, month, days)
+		case c.dom.weekday > 0:
+			return c.dom.weekday <= days && day == nearestWeekday(year, month, c.dom.weekday)
+		default:
+			return c.dom.days[day]
+		}
+	}
+
+	switch {
+	case c.dow.last > 0:
+		return weekday == c.dow.last && day+7 > days
+	case c.dow.nth > 0:
+		return weekday == c.dow.nth && (day-1)/7+1 == c.dow.k
+	default:
+		return c.dow.days[weekday]
+	}
+}
+
+// nearestWeekday returns the weekday (Monday to Friday) nearest the specified day of the month,
+// without crossing into a different month.
+func nearestWeekday(year int, month time.Month, day int) int {
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == daysIn(year, month) {
+			return day - 2
+		}
+		return day + 1
+	default:
+		return day
+	}
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func parseCronDayOfMonth(s string) (cronDayOfMonth, error) {
+	var dom cronDayOfMonth
+
+	switch {
+	case s == "?":
+		dom.any = true
+	case s == "L":
+		dom.last = true
+	case s == "LW":
+		dom.lastWeekday = true
+	case strings.HasSuffix(s, "W"):
+		v, err := parseCronValue(strings.TrimSuffix(s, "W"), 1, 31, nil)
+		if err != nil {
+			return dom, err
+		}
+		dom.weekday = v
+	default:
+		days, err := parseCronField(s, 1, 31, nil)
+		if err != nil {
+			return dom, err
+		}
+		dom.days = days
+	}
+
+	return dom, nil
+}
+
+func parseCronDayOfWeek(s string) (cronDayOfWeek, error) {
+	var dow cronDayOfWeek
+
+	switch {
+	case s == "?":
+		dow.any = true
+	case s == "L":
+		// L on its own is the last day of the week, Saturday.
+		dow.days = make([]bool, 8)
+		dow.days[7] = true
+	case strings.HasSuffix(s, "L"):
+		v, err := parseCronValue(strings.TrimSuffix(s, "L"), 1, 7, dayOfWeekNames)
+		if err != nil {
+			return dow, err
+		}
+		dow.last = v
+	case strings.Contains(s, "#"):
+		day, k, _ := strings.Cut(s, "#")
+		v, err := parseCronValue(day, 1, 7, dayOfWeekNames)
+		if err != nil {
+			return dow, err
+		}
+		n, err := parseCronValue(k, 1, 5, nil)
+		if err != nil {
+			return dow, err
+		}
+		dow.nth, dow.k = v, n
+	default:
+		days, err := parseCronField(s, 1, 7, dayOfWeekNames)
+		if err != nil {
+			return dow, err
+		}
+		dow.days = days
+	}
+
+	return dow, nil
+}
+
+// parseCronField parses a comma-separated list of values, ranges, wildcards and increments.
+// The result is indexed by value.
+func parseCronField(s string, lo, hi int, names map[string]int) ([]bool, error) {
+	result := make([]bool, hi+1)
+
+	for _, item := range strings.Split(s, ",") {
+		rng, step, hasStep := strings.Cut(item, "/")
+
+		increment := 1
+		if hasStep {
+			v, err := strconv.Atoi(step)
+			if err != nil || v < 1 {
+				return nil, fmt.Errorf("%w: invalid increment %q", ErrSyntax, step)
+			}
+			increment = v
+		}
+
+		var start, end int
+		switch {
+		case rng == "*":
+			start, end = lo, hi
+		case strings.Contains(rng, "-"):
+			from, to, _ := strings.Cut(rng, "-")
+			var err error
+			if start, err = parseCronValue(from, lo, hi, names); err != nil {
+				return nil, err
+			}
+			if end, err = parseCronValue(to, lo, hi, names); err != nil {
+				return nil, err
+			}
+			if start > end {
+				// Ranges such as FRI-MON wrap around, except for years.
+				if lo == minYear {
+					return nil, fmt.Errorf("%w: invalid range %q", ErrSyntax, rng)
+				}
+				end += hi - lo + 1
+			}
+		default:
+			v, err := parseCronValue(rng, lo, hi, names)
+			if err != nil {
+				return nil, err
+			}
+			start, end = v, v
+			if hasStep {
+				end = hi
+			}
+		}
+
+		for v := start; v <= end; v += increment {
+			if v > hi {
+				result[v-(hi-lo+1)] = true
+			} else {
+				result[v] = true
+			}
+		}
+	}
+
+	if !slices.Contains(result, true) {
+		return nil, fmt.Errorf("%w: %q matches no values", ErrSyntax, s)
+	}
+
+	return result, nil
+}
+
+func parseCronValue(s string, lo, hi int, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+
+	if s == "" || strings.TrimLeft(s, "0123456789") != "" {
+		return 0, fmt.Errorf("%w: invalid value %q", ErrSyntax, s)
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid value %q", ErrSyntax, s)
+	}
+	if v < lo || v > hi {
+		return 0, fmt.Errorf("%w: value %d out of range [%d, %d]", ErrSyntax, v, lo, hi)
+	}
+
+	return v, nil
+}
+
+func unwrap(s, name string) (string, bool) {
+	v, ok := strings.CutPrefix(s, name+"(")
+	if !ok {
+		return "", false
+	}
+
+	return strings.CutSuffix(v, ")")
+}
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		input       string
+		expectedErr bool
+	}{
+		"empty":                  {input: "", expectedErr: true},
+		"unknown":                {input: "every(5 minutes)", expectedErr: true},
+		"rate minute":            {input: "rate(1 minute)"},
+		"rate minutes":           {input: "rate(5 minutes)"},
+		"rate hours":             {input: "rate(12 hours)"},
+		"rate day":               {input: "rate(1 day)"},
+		"rate plural for 1":      {input: "rate(1 minutes)", expectedErr: true},
+		"rate singular for 5":    {input: "rate(5 minute)", expectedErr: true},
+		"rate zero":              {input: "rate(0 minutes)", expectedErr: true},
+		"rate negative":          {input: "rate(-1 minutes)", expectedErr: true},
+		"rate unit":              {input: "rate(5 weeks)", expectedErr: true},
+		"rate missing unit":      {input: "rate(5)", expectedErr: true},
+		"at":                     {input: "at(2024-11-20T13:00:00)"},
+		"at invalid":             {input: "at(2024-11-20 13:00)", expectedErr: true},
+		"cron every minute":      {input: "cron(* * * * ? *)"},
+		"cron daily":             {input: "cron(0 10 * * ? *)"},
+		"cron weekdays":          {input: "cron(15 12 ? * MON-FRI *)"},
+		"cron increments":        {input: "cron(0/15 * * * ? *)"},
+		"cron lists":             {input: "cron(0 8,12,16 ? * SUN,SAT 2025-2030)"},
+		"cron last day":          {input: "cron(0 18 L * ? *)"},
+		"cron last weekday":      {input: "cron(0 18 LW * ? *)"},
+		"cron nearest weekday":   {input: "cron(0 9 15W * ? *)"},
+		"cron last friday":       {input: "cron(0 9 ? * 6L *)"},
+		"cron nth":               {input: "cron(0 9 ? * 3#2 *)"},
+		"cron month names":       {input: "cron(0 9 1 JAN-MAR ? *)"},
+		"cron 5 fields":          {input: "cron(0 10 * * ?)", expectedErr: true},
+		"cron both days":         {input: "cron(0 10 * * * *)", expectedErr: true},
+		"cron neither day":       {input: "cron(0 10 ? * ? *)", expectedErr: true},
+		"cron minute range":      {input: "cron(60 10 * * ? *)", expectedErr: true},
+		"cron hour range":        {input: "cron(0 24 * * ? *)", expectedErr: true},
+		"cron day range":         {input: "cron(0 10 32 * ? *)", expectedErr: true},
+		"cron day-of-week range": {input: "cron(0 10 ? * 8 *)", expectedErr: true},
+		"cron year range":        {input: "cron(0 10 * * ? 2200)", expectedErr: true},
+		"cron wrapped range":     {input: "cron(0 10 ? * FRI-MON *)"},
+		"cron reversed years":    {input: "cron(0 10 * * ? 2030-2025)", expectedErr: true},
+		"cron nth range":         {input: "cron(0 9 ? * 3#6 *)", expectedErr: true},
+		"cron invalid value":     {input: "cron(0 10 * * ? x)", expectedErr: true},
+		"cron invalid increment": {input: "cron(0/0 10 * * ? *)", expectedErr: true},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(testcase.input)
+
+			if got, want := err != nil, testcase.expectedErr; got != want {
+				t.Errorf("Parse(%q) err %t (%v), want %t", testcase.input, got, err, want)
+			}
+			if err != nil && !errors.Is(err, ErrSyntax) {
+				t.Errorf("Parse(%q) err = %v, want %v", testcase.input, err, ErrSyntax)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, time.February, 27, 10, 30, 0, 0, time.UTC)
+	vancouver, err := time.LoadLocation("America/Vancouver")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testcases := map[string]struct {
+		input    string
+		start    time.Time
+		expected []time.Time
+	}{
+		"rate": {
+			input: "rate(5 minutes)",
+			start: start,
+			expected: []time.Time{
+				time.Date(2024, time.February, 27, 10, 35, 0, 0, time.UTC),
+				time.Date(2024, time.February, 27, 10, 40, 0, 0, time.UTC),
+			},
+		},
+		"at future": {
+			input: "at(2024-03-01T09:00:00)",
+			start: start,
+			expected: []time.Time{
+				time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		"at past": {
+			input: "at(2024-01-01T09:00:00)",
+			start: start,
+		},
+		"cron daily": {
+			input: "cron(0 10 * * ? *)",
+			start: start,
+			expected: []time.Time{
+				time.Date(2024, time.February, 28, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, time.February, 29, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron increments": {
+			input: "cron(0/20 10 * * ? *)",
+			start: start,
+			expected: []time.Time{
+				time.Date(2024, time.February, 27, 10, 40, 0, 0, time.UTC),
+				time.Date(2024, time.February, 28, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron last day": {
+			input: "cron(0 18 L * ? *)",
+			start: start,
+			expected: []time.Time{
+				time.Date(2024, time.February, 29, 18, 0, 0, 0, time.UTC),
+				time.Date(2024, time.March, 31, 18, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron last weekday": {
+			input: "cron(0 18 LW * ? *)",
+			start: start,
+			expected: []time.Time{
+				time.Date(2024, time.February, 29, 18, 0, 0, 0, time.UTC),
+				time.Date(2024, time.March, 29, 18, 0, 0, 0, time.UTC), // March 31 2024 is a Sunday.
+			},
+		},
+		"cron nearest weekday": {
+			input: "cron(0 9 1W * ? *)",
+			start: start,
+			expected: []time.Time{
+				time.Date(2024, time.March, 1, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, time.April, 1, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, time.May, 1, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, time.June, 3, 9, 0, 0, 0, time.UTC), // June 1 2024 is a Saturday.
+			},
+		},
+		"cron last friday": {
+			input: "cron(0 9 ? * 6L *)",
+			start: start,
+			expected: []time.Time{
+				time.Date(2024, time.March, 29, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, time.April, 26, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron second tuesday": {
+			input: "cron(0 9 ? * TUE#2 *)",
+			start: start,
+			expected: []time.Time{
+				time.Date(2024, time.March, 12, 9, 0, 0, 0, time.UTC),
+				time.Date(2024, time.April, 9, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron weekdays": {
+			input: "cron(15 12 ? * MON-FRI *)",
+			start: time.Date(2024, time.March, 1, 13, 0, 0, 0, time.UTC), // Friday.
+			expected: []time.Time{
+				time.Date(2024, time.March, 4, 12, 15, 0, 0, time.UTC),
+				time.Date(2024, time.March, 5, 12, 15, 0, 0, time.UTC),
+			},
+		},
+		"cron wrapped range": {
+			input: "cron(0 10 ? * FRI-MON *)",
+			start: time.Date(2024, time.March, 1, 11, 0, 0, 0, time.UTC), // Friday.
+			expected: []time.Time{
+				time.Date(2024, time.March, 2, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, time.March, 3, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, time.March, 4, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, time.March, 8, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron year": {
+			input: "cron(0 0 1 1 ? 2025-2026)",
+			start: start,
+			expected: []time.Time{
+				time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"cron timezone": {
+			input: "cron(30 2 * * ? *)",
+			start: time.Date(2024, time.March, 9, 12, 0, 0, 0, vancouver),
+			expected: []time.Time{
+				time.Date(2024, time.March, 11, 2, 30, 0, 0, vancouver), // 02:30 on March 10 does not exist.
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := Parse(testcase.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) err %v", testcase.input, err)
+			}
+
+			next := testcase.start
+			for i, want := range testcase.expected {
+				got, ok := expr.Next(next)
+				if !ok {
+					t.Fatalf("%d: Next(%s) = false, want %s", i, next, want)
+				}
+				if !got.Equal(want) {
+					t.Fatalf("%d: Next(%s) = %s, want %s", i, next, got, want)
+				}
+				next = got
+			}
+
+			if len(testcase.expected) == 0 {
+				if got, ok := expr.Next(next); ok {
+					t.Errorf("Next(%s) = %s, want none", next, got)
+				}
+			}
+		})
+	}
+}
@@ -5,12 +5,14 @@ package verify
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // Find JSON diff functions in the json.go file.
@@ -22,11 +24,17 @@ import (
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
 func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig(ctx)
+	defaultTagsConfig := tftags.ResourceDefaultConfig(meta.(*conns.AWSClient).DefaultTagsConfig(ctx), diff)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig(ctx)
 
 	resourceTags := tftags.New(ctx, diff.Get("tags").(map[string]interface{}))
 
+	if diff.GetRawPlan().GetAttr("tags").IsWhollyKnown() {
+		if err := checkTagPolicy(ctx, meta.(*conns.AWSClient).TagPolicy(ctx), defaultTagsConfig.MergeTags(resourceTags)); err != nil {
+			return err
+		}
+	}
+
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
@@ -42,7 +50,8 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 		return nil
 	}
 
-	if diff.HasChange("tags") {
+	// Opting in to or out of provider default tags changes "tags_all" as a change to "tags" does.
+	if diff.HasChanges("tags", names.AttrSkipDefaultTags, names.AttrDefaultTagsExclude) {
 		_, n := diff.GetChange("tags")
 		newTags := tftags.New(ctx, n.(map[string]interface{}))
 
@@ -63,7 +72,7 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 				return fmt.Errorf("setting new tags_all diff: %w", err)
 			}
 		}
-	} else if !diff.HasChange("tags") {
+	} else if !diff.HasChanges("tags", names.AttrSkipDefaultTags, names.AttrDefaultTagsExclude) {
 		if len(allTags) > 0 && !allTags.HasZeroValue() {
 			if err := diff.SetNew("tags_all", allTags.Map()); err != nil {
 				return fmt.Errorf("setting new tags_all diff: %w", err)
@@ -103,6 +112,21 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 	return nil
 }
 
+// checkTagPolicy returns an error if the tags of the resource in Context violate the tag policy.
+func checkTagPolicy(ctx context.Context, policy *tftags.TagPolicy, tags tftags.KeyValueTags) error {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	var errs []error
+	for _, v := range policy.Check(inContext.TypeName, tags) {
+		errs = append(errs, v)
+	}
+
+	return errors.Join(errs...)
+}
+
 // SuppressEquivalentRoundedTime returns a difference suppression function that compares
 // two time value with the specified layout rounded to the specified duration.
 func SuppressEquivalentRoundedTime(layout string, d time.Duration) schema.SchemaDiffSuppressFunc {
@@ -5,17 +5,22 @@ package verify
 
 import (
 	"bytes"
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"log"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 // SuppressEquivalentPolicyDiffs returns a difference suppression function that compares
@@ -213,6 +218,219 @@ func LegacyPolicyNormalize(policy interface{}) (string, error) {
 	return n, nil
 }
 
+// PolicyCanonicalize returns a canonical JSON policy document. Statements are
+// sorted by Sid and content, the values of Action, NotAction, Resource, NotResource, Principal,
+// NotPrincipal and Condition elements are sorted and de-duplicated, and
+// single-element arrays are collapsed to scalars. As with LegacyPolicyNormalize,
+// the Version element is first in the JSON.
+// Principals are normalized as awspolicyequivalence compares them: root user ARNs are
+// converted to account IDs, which are partition independent, and empty principal types are removed.
+// The Effect element is normalized to "Allow" or "Deny".
+// An empty policy document ("{}") is represented by an empty string.
+// Semantically equivalent policies have the same canonical representation.
+func PolicyCanonicalize(policy string) (string, error) {
+	if v := strings.TrimSpace(policy); v == "" || v == "{}" {
+		return "", nil
+	}
+
+	dec := json.NewDecoder(strings.NewReader(policy))
+	dec.UseNumber()
+
+	var doc map[string]interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return "", fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	if v, ok := doc["Statement"]; ok {
+		statements, err := canonicalPolicyStatements(v)
+		if err != nil {
+			return "", err
+		}
+		doc["Statement"] = statements
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+
+	return LegacyPolicyNormalize(string(b))
+}
+
+func canonicalPolicyStatements(v interface{}) ([]interface{}, error) {
+	var statements []interface{}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	case []interface{}:
+		statements = v
+	default:
+		return nil, fmt.Errorf("unsupported data type %T for policy Statement", v)
+	}
+
+	type keyedStatement struct {
+		sid       string
+		key       string
+		statement map[string]interface{}
+	}
+	keyed := make([]keyedStatement, 0, len(statements))
+
+	for _, v := range statements {
+		statement, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unsupported data type %T for policy Statement", v)
+		}
+
+		for _, k := range []string{"Action", "NotAction", "Resource", "NotResource"} {
+			if v, ok := statement[k]; ok {
+				value, err := canonicalPolicyStringSet(v)
+				if err != nil {
+					return nil, fmt.Errorf("policy Statement %s: %w", k, err)
+				}
+				statement[k] = value
+			}
+		}
+
+		if v, ok := statement["Effect"].(string); ok {
+			for _, effect := range []string{"Allow", "Deny"} {
+				if strings.EqualFold(v, effect) {
+					statement["Effect"] = effect
+				}
+			}
+		}
+
+		for _, k := range []string{"Principal", "NotPrincipal"} {
+			switch v := statement[k].(type) {
+			case nil:
+			case string:
+				statement[k] = canonicalPolicyPrincipal(v)
+			case map[string]interface{}:
+				for typ, identifiers := range v {
+					value, err := canonicalPolicyStringSet(identifiers, canonicalPolicyPrincipal)
+					if err != nil {
+						return nil, fmt.Errorf("policy Statement %s %s: %w", k, typ, err)
+					}
+					if value, ok := value.([]string); ok && len(value) == 0 {
+						delete(v, typ)
+						continue
+					}
+					v[typ] = value
+				}
+				if len(v) == 0 {
+					delete(statement, k)
+				}
+			default:
+				return nil, fmt.Errorf("unsupported data type %T for policy Statement %s", v, k)
+			}
+		}
+
+		switch v := statement["Condition"].(type) {
+		case nil:
+		case map[string]interface{}:
+			for operator, conditions := range v {
+				conditions, ok := conditions.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("unsupported data type %T for policy Statement Condition %s", v[operator], operator)
+				}
+				for key, values := range conditions {
+					value, err := canonicalPolicyStringSet(values)
+					if err != nil {
+						return nil, fmt.Errorf("policy Statement Condition %s %s: %w", operator, key, err)
+					}
+					conditions[key] = value
+				}
+			}
+		default:
+			return nil, fmt.Errorf("unsupported data type %T for policy Statement Condition", v)
+		}
+
+		b, err := json.Marshal(statement)
+		if err != nil {
+			return nil, err
+		}
+		sid, _ := statement["Sid"].(string)
+		keyed = append(keyed, keyedStatement{sid: sid, key: string(b), statement: statement})
+	}
+
+	slices.SortStableFunc(keyed, func(a, b keyedStatement) int {
+		return cmp.Or(strings.Compare(a.sid, b.sid), strings.Compare(a.key, b.key))
+	})
+
+	result := make([]interface{}, len(keyed))
+	for i, v := range keyed {
+		result[i] = v.statement
+	}
+
+	return result, nil
+}
+
+// canonicalPolicyPrincipal returns the canonical form of a principal identifier.
+// Root user ARNs are converted to the account ID, which IAM treats as equivalent.
+func canonicalPolicyPrincipal(principal string) string {
+	if v, err := arn.Parse(principal); err == nil && v.Service == "iam" && v.Resource == "root" && itypes.IsAWSAccountID(v.AccountID) {
+		return v.AccountID
+	}
+
+	return principal
+}
+
+// canonicalPolicyStringSet returns the sorted, de-duplicated string values of
+// a policy element, or the single value if there is only one.
+// Boolean and numeric values are converted to strings, as IAM does.
+// Each value is passed through any normalization functions before sorting.
+func canonicalPolicyStringSet(v interface{}, normalizeFns ...func(string) string) (interface{}, error) {
+	var values []string
+
+	switch v := v.(type) {
+	case []interface{}:
+		for _, v := range v {
+			s, err := policyScalarString(v)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, s)
+		}
+	default:
+		s, err := policyScalarString(v)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, s)
+	}
+
+	for _, f := range normalizeFns {
+		for i, v := range values {
+			values[i] = f(v)
+		}
+	}
+
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	switch len(values) {
+	case 0:
+		return []string{}, nil
+	case 1:
+		return values[0], nil
+	default:
+		return values, nil
+	}
+}
+
+func policyScalarString(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case json.Number:
+		return v.String(), nil
+	default:
+		return "", fmt.Errorf("unsupported data type %T", v)
+	}
+}
+
 // LegacyPolicyToSet returns the existing policy if the new policy is equivalent.
 // Otherwise, it returns the new policy. Either policy is legacy normalized.
 func LegacyPolicyToSet(exist, new string) (string, error) {
@@ -851,3 +851,150 @@ func TestLegacyPolicyNormalize(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicyCanonicalize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Name:     "empty",
+			Input:    ``,
+			Expected: ``,
+		},
+		{
+			Name:     "empty JSON",
+			Input:    `{}`,
+			Expected: ``,
+		},
+		{
+			Name:     "single statement",
+			Input:    `{"Statement":{"Action":["s3:GetObject"],"Effect":"Allow","Resource":["*"]},"Version":"2012-10-17"}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+		},
+		{
+			Name: "sorted statements and values",
+			Input: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "B",
+      "Effect": "Allow",
+      "Action": ["s3:PutObject", "s3:GetObject", "s3:GetObject"],
+      "Resource": "*"
+    },
+    {
+      "Sid": "A",
+      "Effect": "Deny",
+      "NotAction": "iam:*",
+      "Resource": ["arn:aws:s3:::b", "arn:aws:s3:::a"]
+    }
+  ]
+}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotAction":"iam:*","Resource":["arn:aws:s3:::a","arn:aws:s3:::b"],"Sid":"A"},{"Action":["s3:GetObject","s3:PutObject"],"Effect":"Allow","Resource":"*","Sid":"B"}]}`,
+		},
+		{
+			Name: "principals and conditions",
+			Input: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "AWS": ["arn:aws:iam::123456789012:root", "arn:aws:iam::111122223333:root"],
+        "Service": ["ec2.amazonaws.com"]
+      },
+      "Condition": {
+        "Bool": {"aws:MultiFactorAuthPresent": true},
+        "StringEquals": {"sts:ExternalId": ["b", "a"]}
+      }
+    }
+  ]
+}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"sts:AssumeRole","Condition":{"Bool":{"aws:MultiFactorAuthPresent":"true"},"StringEquals":{"sts:ExternalId":["a","b"]}},"Effect":"Allow","Principal":{"AWS":["111122223333","123456789012"],"Service":"ec2.amazonaws.com"}}]}`,
+		},
+		{
+			Name:     "wildcard principal",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Principal":"*","Resource":"*"}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Principal":"*","Resource":"*"}]}`,
+		},
+		{
+			Name:     "account ID principal",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":["123456789012","arn:aws:iam::123456789012:root"]}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"AWS":"123456789012"}}]}`,
+		},
+		{
+			Name:     "account ID principal other partition",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Principal":{"AWS":"123456789012"},"Resource":"arn:aws-us-gov:s3:::b/*"}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Principal":{"AWS":"123456789012"},"Resource":"arn:aws-us-gov:s3:::b/*"}]}`,
+		},
+		{
+			Name:     "root user principal other partition",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Principal":{"AWS":"arn:aws-cn:iam::123456789012:root"},"Resource":"arn:aws:s3:::b/*"}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Principal":{"AWS":"123456789012"},"Resource":"arn:aws:s3:::b/*"}]}`,
+		},
+		{
+			Name:     "non-root user principal",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":"arn:aws:iam::123456789012:user/root"}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:user/root"}}]}`,
+		},
+		{
+			Name:     "effect case",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Effect":"allow","Action":"s3:GetObject","Resource":"*"},{"Effect":"DENY","Action":"s3:PutObject","Resource":"*"}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"},{"Action":"s3:PutObject","Effect":"Deny","Resource":"*"}]}`,
+		},
+		{
+			Name:     "empty principal type",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"AWS":[],"Service":"ec2.amazonaws.com"}}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"}}]}`,
+		},
+		{
+			Name:     "empty principal",
+			Input:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Principal":{"AWS":[]},"Resource":"*"}]}`,
+			Expected: `{"Version":"2012-10-17","Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+		},
+		{
+			Name:  "invalid JSON",
+			Input: `{"Version":"2012-10-17",`,
+			Error: true,
+		},
+		{
+			Name:  "invalid statement",
+			Input: `{"Version":"2012-10-17","Statement":"Allow"}`,
+			Error: true,
+		},
+		{
+			Name:  "invalid action",
+			Input: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":{"s3":"GetObject"},"Resource":"*"}]}`,
+			Error: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := PolicyCanonicalize(tc.Input)
+
+			if tc.Error {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("expected no error, got: %s", err)
+			}
+
+			if p != tc.Expected {
+				t.Errorf("expected %s, got: %s", tc.Expected, p)
+			}
+		})
+	}
+}
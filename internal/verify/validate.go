@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
 )
 
@@ -397,6 +398,44 @@ func ValidOnceAWeekWindowFormat(v interface{}, k string) (ws []string, errors []
 	return
 }
 
+// ValidScheduleExpression validates a cron(...), rate(...) or at(...) schedule expression,
+// as used by EventBridge Scheduler schedules.
+func ValidScheduleExpression(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		return
+	}
+
+	if _, err := schedule.Parse(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid schedule expression: %w", k, err))
+	}
+
+	return
+}
+
+// ValidRecurringScheduleExpression validates a cron(...) or rate(...) schedule expression,
+// as used by EventBridge rules.
+func ValidRecurringScheduleExpression(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		return
+	}
+
+	expr, err := schedule.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid schedule expression: %w", k, err))
+		return
+	}
+
+	if _, ok := expr.(schedule.At); ok {
+		errors = append(errors, fmt.Errorf("%q must be a cron(...) or rate(...) expression, got %q", k, value))
+	}
+
+	return
+}
+
 var (
 	ValidRegionName = validation.StringMatch(regionRegexp, "must be a valid AWS Region Code")
 )
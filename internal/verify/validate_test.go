@@ -577,6 +577,61 @@ func TestValidOnceADayWindowFormat(t *testing.T) {
 	}
 }
 
+func TestValidScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value             string
+		ErrCount          int
+		RecurringErrCount int
+	}{
+		{
+			Value: "",
+		},
+		{
+			Value: "rate(5 minutes)",
+		},
+		{
+			Value: "cron(0 10 ? * MON-FRI *)",
+		},
+		{
+			Value:             "at(2024-11-20T13:00:00)",
+			RecurringErrCount: 1,
+		},
+		{
+			// 5 fields
+			Value:             "cron(0 10 * * ?)",
+			ErrCount:          1,
+			RecurringErrCount: 1,
+		},
+		{
+			// both day-of-month and day-of-week
+			Value:             "cron(0 10 * * * *)",
+			ErrCount:          1,
+			RecurringErrCount: 1,
+		},
+		{
+			Value:             "rate(5 minute)",
+			ErrCount:          1,
+			RecurringErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidScheduleExpression(tc.Value, "schedule_expression")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors, But got %d errors for \"%s\"", tc.ErrCount, len(errors), tc.Value)
+		}
+
+		_, errors = ValidRecurringScheduleExpression(tc.Value, "schedule_expression")
+
+		if len(errors) != tc.RecurringErrCount {
+			t.Fatalf("Expected %d recurring validation errors, But got %d errors for \"%s\"", tc.RecurringErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
 func TestValidLaunchTemplateName(t *testing.T) {
 	t.Parallel()
 
@@ -10,6 +10,7 @@ import (
 	"runtime/debug"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
@@ -44,6 +45,11 @@ func main() {
 		serveOpts...,
 	)
 
+	// Serve returns once Terraform has shut down the provider.
+	if err := conns.WriteAPIMetrics(); err != nil {
+		log.Printf("[WARN] %s", err)
+	}
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -54,6 +54,7 @@ const (
 	AttrDatabase                   = "database"
 	AttrDatabaseName               = "database_name"
 	AttrDefaultAction              = "default_action"
+	AttrDefaultTagsExclude         = "default_tags_exclude"
 	AttrDefaultValue               = "default_value"
 	AttrDeleteOnTermination        = "delete_on_termination"
 	AttrDeletionProtection         = "deletion_protection"
@@ -184,6 +185,7 @@ const (
 	AttrSharedConfigFiles          = "shared_config_files"
 	AttrSize                       = "size"
 	AttrSkipCredentialsValidation  = "skip_credentials_validation"
+	AttrSkipDefaultTags            = "skip_default_tags"
 	AttrSkipDestroy                = "skip_destroy"
 	AttrSkipRequestingAccountID    = "skip_requesting_account_id"
 	AttrSnapshotID                 = "snapshot_id"
@@ -59,6 +59,64 @@ func TestPartitionForRegion(t *testing.T) {
 	}
 }
 
+func TestServicePrincipalSuffixForPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		service  string
+		region   string
+		expected string
+	}{
+		{
+			name:     "standard",
+			service:  "logs",
+			region:   endpoints.UsWest2RegionID,
+			expected: "amazonaws.com",
+		},
+		{
+			name:     "China special case",
+			service:  "logs",
+			region:   endpoints.CnNorth1RegionID,
+			expected: "amazonaws.com.cn",
+		},
+		{
+			name:     "China",
+			service:  "ec2",
+			region:   endpoints.CnNorth1RegionID,
+			expected: "amazonaws.com",
+		},
+		{
+			name:     "ISO special case",
+			service:  "config",
+			region:   endpoints.UsIsoEast1RegionID,
+			expected: "c2s.ic.gov",
+		},
+		{
+			name:     "ISO-B special case",
+			service:  "dms",
+			region:   endpoints.UsIsobEast1RegionID,
+			expected: "sc2s.sgov.gov",
+		},
+		{
+			name:     "GovCloud",
+			service:  "logs",
+			region:   endpoints.UsGovWest1RegionID,
+			expected: "amazonaws.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ServicePrincipalSuffixForPartition(testCase.service, PartitionForRegion(testCase.region)), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
 func TestProviderPackageForAlias(t *testing.T) {
 	t.Parallel()
 
@@ -4,7 +4,10 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-provider-aws/skaff/resource"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdkshape"
 	"github.com/spf13/cobra"
 )
 
@@ -15,12 +18,20 @@ var (
 	force         bool
 	pluginSDKV2   bool
 	includeTags   bool
+	fromSDK       string
+	operations    sdkshape.Operations
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("from-sdk") {
+			if pluginSDKV2 {
+				return fmt.Errorf("--from-sdk generates Terraform Plugin Framework resources only")
+			}
+			return resource.CreateFromSDK(name, snakeName, fromSDK, operations, !clearComments, force)
+		}
 		return resource.Create(name, snakeName, !clearComments, force, !pluginSDKV2, includeTags)
 	},
 }
@@ -33,4 +44,10 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&fromSDK, "from-sdk", "", "generate the schema, model, finder, waiters and sweeper from the shapes of this AWS SDK for Go v2 service package (e.g., amp)")
+	resourceCmd.Flags().StringVar(&operations.Create, "create-op", "", "with --from-sdk, the AWS API operation that creates the resource (e.g., CreateWorkspace)")
+	resourceCmd.Flags().StringVar(&operations.Read, "read-op", "", "with --from-sdk, the AWS API operation that reads the resource (e.g., DescribeWorkspace)")
+	resourceCmd.Flags().StringVar(&operations.Update, "update-op", "", "with --from-sdk, the AWS API operation that updates the resource, if any (e.g., UpdateWorkspaceAlias)")
+	resourceCmd.Flags().StringVar(&operations.Delete, "delete-op", "", "with --from-sdk, the AWS API operation that deletes the resource (e.g., DeleteWorkspace)")
+	resourceCmd.Flags().StringVar(&operations.List, "list-op", "", "with --from-sdk, the AWS API operation that lists the resources for the sweeper, if any (e.g., ListWorkspaces)")
 }
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	_ "embed"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdkshape"
+)
+
+//go:embed resourcefromsdk.gtpl
+var resourceFromSDKTmpl string
+
+//go:embed sweepfromsdk.gtpl
+var sweepFromSDKTmpl string
+
+// CreateFromSDK creates a Plugin Framework resource whose schema, model, finder, waiters and sweeper
+// are generated from the shapes of the resource's AWS SDK for Go v2 lifecycle operations.
+func CreateFromSDK(resName, snakeName, sdkPackage string, ops sdkshape.Operations, comments, force bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if resName == "" {
+		resName = strings.TrimPrefix(ops.Create, "Create")
+	}
+
+	if resName == "" || resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	if snakeName == "" {
+		snakeName = names.ToSnakeCase(resName)
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	if sdkPackage == "" {
+		sdkPackage = service.GoV2Package()
+	}
+
+	dir, err := sdkshape.ModuleDir(sdkPackage)
+	if err != nil {
+		return err
+	}
+
+	sdkService, err := sdkshape.Load(sdkPackage, dir)
+	if err != nil {
+		return fmt.Errorf("loading AWS SDK for Go v2 service package %q: %w", sdkPackage, err)
+	}
+
+	shape, err := sdkService.Resource(resName, ops)
+	if err != nil {
+		return fmt.Errorf("generating resource %s from AWS SDK for Go v2 service package %q: %w", resName, sdkPackage, err)
+	}
+
+	templateData := TemplateData{
+		Resource:             resName,
+		ResourceLower:        strings.ToLower(resName),
+		ResourceSnake:        snakeName,
+		HumanFriendlyService: service.HumanFriendly(),
+		IncludeComments:      comments,
+		IncludeTags:          shape.Tags,
+		SDKPackage:           sdkPackage,
+		ServicePackage:       servicePackage,
+		Service:              service.ProviderNameUpper(),
+		ServiceLower:         strings.ToLower(service.ProviderNameUpper()),
+		AWSServiceName:       service.FullHumanFriendly(),
+		PluginFramework:      true,
+		HumanResourceName:    convert.ToHumanResName(resName),
+		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+
+		Shape:                   shape,
+		Waiters:                 shape.Status != nil && len(shape.Status.Pending) > 0 && len(shape.Status.Target) > 0,
+		DisplayField:            displayField(shape),
+		TagsIdentifierAttribute: tagsIdentifierAttribute(shape),
+	}
+	if templateData.Waiters {
+		templateData.DeletePending = append(slices.Clone(shape.Status.Deleting), shape.Status.Target...)
+	}
+	templateData.Imports = resourceFromSDKImports(templateData)
+
+	f := fmt.Sprintf("%s.go", snakeName)
+	if err = writeGoTemplate("newres", f, resourceFromSDKTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	if shape.List != nil {
+		sf := fmt.Sprintf("%s_sweep.go", snakeName)
+		if err = writeGoTemplate("sweep", sf, sweepFromSDKTmpl, force, templateData); err != nil {
+			return fmt.Errorf("writing resource sweeper template: %w", err)
+		}
+	}
+
+	tf := fmt.Sprintf("%s_test.go", snakeName)
+	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	for _, v := range shape.Unsupported {
+		fmt.Fprintf(os.Stderr, "skipped unsupported field: %s\n", v)
+	}
+
+	return nil
+}
+
+// writeGoTemplate writes a template like writeTemplate and then formats the Go source.
+// The unformatted source is left in place if it cannot be formatted.
+func writeGoTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if err := writeTemplate(templateName, filename, tmpl, force, td); err != nil {
+		return err
+	}
+
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	formatted, err := format.Source(contents)
+	if err != nil {
+		return fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+	}
+
+	if err := os.WriteFile(filename, formatted, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+// displayField returns the model field used to identify the resource in error messages before it is created.
+func displayField(shape *sdkshape.Resource) string {
+	if shape.PlanIdentifier != "" {
+		return shape.PlanIdentifier
+	}
+
+	if slices.ContainsFunc(shape.Model.Fields, func(f *sdkshape.Field) bool { return f.Name == "Name" && !f.Computed }) {
+		return "Name"
+	}
+
+	return "ID"
+}
+
+// tagsIdentifierAttribute returns the attribute that identifies the resource to the service's tagging API.
+func tagsIdentifierAttribute(shape *sdkshape.Resource) string {
+	if slices.ContainsFunc(shape.Model.Fields, func(f *sdkshape.Field) bool { return f.TFName == names.AttrARN }) {
+		return names.AttrARN
+	}
+
+	return names.AttrID
+}
+
+// resourceFromSDKImports returns the import specs used by a resource generated from AWS SDK for Go v2 shapes.
+func resourceFromSDKImports(td TemplateData) []string {
+	shape := td.Shape
+	// fields are the fields in the schema, modelFields the fields of all models.
+	fields := allFields(shape.Model)
+	modelFields := slices.Clone(shape.Model.Fields)
+	for _, v := range shape.NestedModels {
+		modelFields = append(modelFields, v.Fields...)
+	}
+
+	uses := func(pred func(*sdkshape.Field) bool) bool {
+		return slices.ContainsFunc(fields, pred)
+	}
+	usesType := func(prefix string) bool {
+		return slices.ContainsFunc(modelFields, func(f *sdkshape.Field) bool {
+			return strings.Contains(f.ModelType, prefix) || strings.Contains(f.CustomType, prefix) || strings.Contains(f.ElementType, prefix) || strings.Contains(f.Builtin, prefix)
+		})
+	}
+
+	std := []string{`"context"`}
+	if td.Waiters {
+		std = append(std, `"time"`)
+	}
+
+	other := []string{
+		`"github.com/aws/aws-sdk-go-v2/aws"`,
+		fmt.Sprintf(`"github.com/aws/aws-sdk-go-v2/service/%s"`, td.SDKPackage),
+		`"github.com/hashicorp/terraform-plugin-framework/resource"`,
+		`"github.com/hashicorp/terraform-plugin-framework/resource/schema"`,
+		`"github.com/hashicorp/terraform-plugin-framework/types"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/create"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/framework"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"`,
+		`"github.com/hashicorp/terraform-provider-aws/internal/tfresource"`,
+		`"github.com/hashicorp/terraform-provider-aws/names"`,
+	}
+
+	if td.Waiters || shape.NotFoundException != "" || strings.HasPrefix(shape.ReadType, "awstypes.") || usesType("awstypes.") {
+		other = append(other, fmt.Sprintf(`awstypes "github.com/aws/aws-sdk-go-v2/service/%s/types"`, td.SDKPackage))
+	}
+	if td.Waiters {
+		other = append(other,
+			`"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"`,
+			`"github.com/hashicorp/terraform-provider-aws/internal/enum"`,
+		)
+	}
+	if td.Waiters || shape.NotFoundException != "" {
+		other = append(other, `"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"`)
+	}
+	if shape.NotFoundException != "" {
+		other = append(other, `"github.com/hashicorp/terraform-provider-aws/internal/errs"`)
+	}
+	if usesType("timetypes.") {
+		other = append(other, `"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"`)
+	}
+	if usesType("fwtypes.") {
+		other = append(other, `fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`)
+	}
+	if uses(func(f *sdkshape.Field) bool { return f.IsBlock() && (f.Required || f.MaxItems1) }) {
+		other = append(other,
+			`"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"`,
+			`"github.com/hashicorp/terraform-plugin-framework/schema/validator"`,
+		)
+	}
+	if td.IncludeTags {
+		other = append(other, `tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"`)
+	}
+
+	var planModifierPackages []string
+	for _, f := range fields {
+		for _, v := range f.PlanModifiers() {
+			pkg, _, _ := strings.Cut(v, ".")
+			planModifierPackages = append(planModifierPackages, pkg)
+		}
+	}
+	if len(planModifierPackages) > 0 {
+		other = append(other, `"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"`)
+		for _, v := range planModifierPackages {
+			other = append(other, fmt.Sprintf(`"github.com/hashicorp/terraform-plugin-framework/resource/schema/%s"`, v))
+		}
+	}
+
+	slices.SortFunc(other, func(a, b string) int {
+		return strings.Compare(importPath(a), importPath(b))
+	})
+
+	return append(append(std, ""), slices.Compact(other)...)
+}
+
+// allFields returns the fields of a model and of the models nested in its schema blocks.
+func allFields(model *sdkshape.Model) []*sdkshape.Field {
+	var fields []*sdkshape.Field
+
+	for _, f := range model.Fields {
+		fields = append(fields, f)
+		if f.IsBlock() {
+			fields = append(fields, allFields(f.Nested)...)
+		}
+	}
+
+	return fields
+}
+
+// importPath returns the path of an import spec, e.g. `fwtypes "example.com/types"` -> "example.com/types".
+func importPath(spec string) string {
+	_, path, ok := strings.Cut(spec, " ")
+	if !ok {
+		path = spec
+	}
+
+	return strings.Trim(path, `"`)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"github.com/hashicorp/terraform-provider-aws/names/data"
 	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+	"github.com/hashicorp/terraform-provider-aws/skaff/sdkshape"
 )
 
 //go:embed resource.gtpl
@@ -46,6 +47,14 @@ type TemplateData struct {
 	PluginFramework      bool
 	HumanResourceName    string
 	ProviderResourceName string
+
+	// Fields used only when generating from AWS SDK for Go v2 shapes.
+	Shape                   *sdkshape.Resource
+	Imports                 []string
+	Waiters                 bool
+	DeletePending           []string
+	DisplayField            string
+	TagsIdentifierAttribute string
 }
 
 func Create(resName, snakeName string, comments, force, pluginFramework, tags bool) error {
@@ -0,0 +1,857 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkshape
+
+import (
+	"fmt"
+	"go/ast"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Operations names the AWS API operations that implement a resource's lifecycle.
+type Operations struct {
+	Create string
+	Read   string
+	Update string
+	Delete string
+	List   string
+}
+
+// Resource is a Plugin Framework resource derived from the API shapes of its lifecycle operations.
+type Resource struct {
+	// Name is the resource name, e.g. "Workspace".
+	Name       string
+	Operations Operations
+
+	// Model is the resource's model. NestedModels are the models of its nested objects.
+	Model        *Model
+	NestedModels []*Model
+	// Unsupported describes the SDK fields whose types cannot be mapped to a Plugin Framework type.
+	Unsupported []string
+	// Tags is whether the Create operation accepts tags.
+	Tags bool
+
+	// Identifier is the Read operation input field that identifies the resource.
+	Identifier string
+	// CreateOutputIdentifier is the selector of the resource's identifier in the Create operation output,
+	// e.g. "WorkspaceId" or "Workspace.WorkspaceId". Empty if the output does not contain the identifier.
+	CreateOutputIdentifier string
+	// PlanIdentifier is the model field that holds the resource's identifier before creation, if any.
+	PlanIdentifier string
+	// DeleteIdentifier is the Delete operation input field that identifies the resource.
+	DeleteIdentifier string
+	// ReadOutputField is the Read operation output field that holds the resource.
+	// Empty if the output is itself the resource.
+	ReadOutputField string
+	// ReadType is the type returned by the resource's finder, e.g. "awstypes.WorkspaceDescription".
+	ReadType string
+	// NotFoundException is the types package error returned when the resource does not exist, if any.
+	NotFoundException string
+
+	// Status describes the resource's status, if it has one.
+	Status *Status
+	// List describes the List operation, if any.
+	List *List
+}
+
+// Status describes the status field of a resource and how its values map to waiter states.
+type Status struct {
+	// Field is the resource's status field, e.g. "Status" or "Status.StatusCode".
+	Field string
+	// Type is the types package enum type of the status, e.g. "WorkspaceStatusCode".
+	Type string
+	// Pending, Target and Deleting are enum constant names, e.g. "WorkspaceStatusCodeCreating".
+	Pending  []string
+	Target   []string
+	Deleting []string
+}
+
+// List describes the List operation used to sweep a resource.
+type List struct {
+	// OutputField is the List operation output field that holds the resource summaries.
+	OutputField string
+	// IDField is the summary field that holds the resource's identifier.
+	IDField string
+	// Paginated is whether the service package has a paginator for the List operation.
+	Paginated bool
+}
+
+// Model is a tfsdk model struct compatible with AutoFlex.
+type Model struct {
+	// Name is the Go type name, e.g. "workspaceModel".
+	Name   string
+	Fields []*Field
+}
+
+// Attributes returns the model's fields that are schema attributes, in Terraform name order.
+func (m *Model) Attributes() []*Field {
+	return m.fieldsWhere(func(f *Field) bool { return !f.IsBlock() })
+}
+
+// Blocks returns the model's fields that are schema blocks, in Terraform name order.
+func (m *Model) Blocks() []*Field {
+	return m.fieldsWhere(func(f *Field) bool { return f.IsBlock() })
+}
+
+func (m *Model) fieldsWhere(pred func(*Field) bool) []*Field {
+	var fields []*Field
+
+	for _, f := range m.Fields {
+		if pred(f) {
+			fields = append(fields, f)
+		}
+	}
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].TFName < fields[j].TFName
+	})
+
+	return fields
+}
+
+// Field is a tfsdk model field and its schema.
+type Field struct {
+	// SDKName is the SDK struct field name, e.g. "KmsKeyArn".
+	SDKName string
+	// Name is the model struct field name, e.g. "KMSKeyARN".
+	Name string
+	// TFName is the Terraform attribute or block name, e.g. "kms_key_arn".
+	TFName string
+	// ModelType is the model struct field type, e.g. "types.String".
+	ModelType string
+
+	// Kind is the Plugin Framework attribute kind, e.g. "String" or "List".
+	Kind string
+	// CustomType and ElementType are expressions for the schema attribute's custom and element types, if any.
+	CustomType  string
+	ElementType string
+	// Builtin is an expression that returns the field's complete schema attribute, if any.
+	Builtin string
+
+	Required bool
+	Optional bool
+	Computed bool
+	// ForceNew is whether a change to the argument requires the resource to be replaced.
+	ForceNew bool
+
+	// Nested is the model of a nested object.
+	Nested *Model
+	// MaxItems1 is whether a nested object is a single struct rather than a slice.
+	MaxItems1 bool
+}
+
+// IsBlock returns whether the field is a schema block.
+func (f *Field) IsBlock() bool {
+	return f.Nested != nil && !f.Computed
+}
+
+// PlanModifiers returns the field's plan modifier expressions.
+func (f *Field) PlanModifiers() []string {
+	pkg := strings.ToLower(f.Kind) + "planmodifier"
+
+	switch {
+	case f.ForceNew:
+		return []string{pkg + ".RequiresReplace()"}
+	case f.Computed && f.Nested == nil && f.Kind != "List" && f.Kind != "Map":
+		return []string{pkg + ".UseStateForUnknown()"}
+	default:
+		return nil
+	}
+}
+
+// skippedFields are SDK fields that are never part of a resource's model.
+var skippedFields = []string{
+	"ClientRequestToken",
+	"ClientToken",
+	"DryRun",
+	"IdempotencyToken",
+	"MaxResults",
+	"NextToken",
+	"ResultMetadata",
+}
+
+// Resource derives a resource from the API shapes of its lifecycle operations.
+// The Create, Read and Delete operations are required.
+func (s *Service) Resource(name string, ops Operations) (*Resource, error) {
+	if ops.Create == "" || ops.Read == "" || ops.Delete == "" {
+		return nil, fmt.Errorf("the Create, Read and Delete operations are required")
+	}
+
+	createIn, err := s.inputStruct(ops.Create)
+	if err != nil {
+		return nil, err
+	}
+	createOut, err := s.outputStruct(ops.Create)
+	if err != nil {
+		return nil, err
+	}
+	readIn, err := s.inputStruct(ops.Read)
+	if err != nil {
+		return nil, err
+	}
+	readOut, err := s.outputStruct(ops.Read)
+	if err != nil {
+		return nil, err
+	}
+	deleteIn, err := s.inputStruct(ops.Delete)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &builder{
+		service:  s,
+		models:   make(map[string]*Model),
+		building: make(map[string]bool),
+	}
+	r := &Resource{
+		Name:       name,
+		Operations: ops,
+	}
+
+	r.Identifier, err = identifierField(readIn, name)
+	if err != nil {
+		return nil, fmt.Errorf("operation %q: %w", ops.Read, err)
+	}
+
+	r.DeleteIdentifier, err = identifierField(deleteIn, name, r.Identifier)
+	if err != nil {
+		return nil, fmt.Errorf("operation %q: %w", ops.Delete, err)
+	}
+
+	// The resource is either a types package struct in the Read output or the Read output itself.
+	readStruct, readInTypes := readOut, false
+	r.ReadType = fmt.Sprintf("%s.%sOutput", s.Package, ops.Read)
+	if field, typeName, ok := s.resourceStructField(readOut, name); ok {
+		readStruct, _ = s.typesStruct(typeName)
+		readInTypes = true
+		r.ReadOutputField = field
+		r.ReadType = "awstypes." + typeName
+	}
+
+	r.CreateOutputIdentifier = s.selectorOf(createOut, r.Identifier)
+
+	var updatable []string
+	if ops.Update != "" {
+		updateIn, err := s.inputStruct(ops.Update)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range fields(updateIn) {
+			updatable = append(updatable, modelFieldName(f.name, name))
+		}
+	}
+
+	model := &Model{Name: "resource" + name + "Model"}
+
+	// Arguments are the fields of the Create operation input.
+	for _, f := range fields(createIn) {
+		if f.name == "Tags" {
+			r.Tags = true
+			continue
+		}
+		if slices.Contains(skippedFields, f.name) {
+			continue
+		}
+
+		field, ok := b.field(f, ops.Create+"Input", false, name, false)
+		if !ok {
+			continue
+		}
+
+		field.Required = f.required
+		field.Optional = !f.required
+		field.ForceNew = ops.Update == "" || !slices.Contains(updatable, field.Name)
+
+		if field.Name == modelFieldName(r.Identifier, name) {
+			r.PlanIdentifier = field.Name
+		}
+
+		model.Fields = append(model.Fields, field)
+	}
+
+	// Attributes are the remaining fields of the resource as read.
+	for _, f := range fields(readStruct) {
+		if f.name == "Tags" || slices.Contains(skippedFields, f.name) {
+			continue
+		}
+		if slices.ContainsFunc(model.Fields, func(v *Field) bool { return v.Name == modelFieldName(f.name, name) }) {
+			continue
+		}
+
+		field, ok := b.field(f, r.ReadType, readInTypes, name, true)
+		if !ok {
+			continue
+		}
+
+		field.Computed = true
+		switch field.TFName {
+		case names.AttrARN:
+			field.Builtin = "framework.ARNAttributeComputedOnly()"
+		case names.AttrID:
+			field.Builtin = "framework.IDAttribute()"
+		}
+
+		model.Fields = append(model.Fields, field)
+	}
+
+	if !slices.ContainsFunc(model.Fields, func(v *Field) bool { return v.TFName == names.AttrID }) {
+		model.Fields = append(model.Fields, &Field{
+			Name:      "ID",
+			TFName:    names.AttrID,
+			ModelType: "types.String",
+			Kind:      "String",
+			Builtin:   "framework.IDAttribute()",
+			Computed:  true,
+		})
+	}
+
+	sort.SliceStable(model.Fields, func(i, j int) bool {
+		return model.Fields[i].Name < model.Fields[j].Name
+	})
+
+	r.Model = model
+	r.NestedModels = b.order
+	r.Unsupported = b.unsupported
+	r.NotFoundException = s.notFoundException()
+	r.Status = s.status(readStruct, readInTypes, name)
+
+	if ops.List != "" {
+		listOut, err := s.outputStruct(ops.List)
+		if err != nil {
+			return nil, err
+		}
+
+		r.List, err = s.list(listOut, ops.List, r.Identifier, name)
+		if err != nil {
+			return nil, fmt.Errorf("operation %q: %w", ops.List, err)
+		}
+	}
+
+	return r, nil
+}
+
+// identifierField returns the required string field of an operation input that identifies the resource.
+func identifierField(in *ast.StructType, resourceName string, preferred ...string) (string, error) {
+	var candidates []string
+
+	for _, f := range fields(in) {
+		if f.required && isString(f.typ) {
+			candidates = append(candidates, f.name)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("no required string input field identifies the resource")
+	}
+
+	preferred = append(preferred,
+		resourceName+"Id", resourceName+"Arn", resourceName+"Name", resourceName+"Identifier",
+		"Id", "Arn", "Name", "Identifier",
+	)
+	for _, v := range preferred {
+		if slices.Contains(candidates, v) {
+			return v, nil
+		}
+	}
+
+	return candidates[0], nil
+}
+
+// resourceStructField returns the field of an operation output that holds a types package struct describing the resource.
+func (s *Service) resourceStructField(out *ast.StructType, resourceName string) (string, string, bool) {
+	var field, typeName string
+
+	for _, f := range fields(out) {
+		v, ok := typesName(f.typ, false)
+		if !ok {
+			continue
+		}
+		if _, ok := s.typesStruct(v); !ok {
+			continue
+		}
+
+		if f.name == resourceName || v == resourceName {
+			return f.name, v, true
+		}
+		if field == "" {
+			field, typeName = f.name, v
+		}
+	}
+
+	return field, typeName, field != ""
+}
+
+// selectorOf returns the selector of the named field in an operation output,
+// either directly or within a types package struct.
+func (s *Service) selectorOf(out *ast.StructType, name string) string {
+	if _, ok := findField(out, name); ok {
+		return name
+	}
+
+	for _, f := range fields(out) {
+		v, ok := typesName(f.typ, false)
+		if !ok {
+			continue
+		}
+		if st, ok := s.typesStruct(v); ok {
+			if _, ok := findField(st, name); ok {
+				return f.name + "." + name
+			}
+		}
+	}
+
+	return ""
+}
+
+// notFoundException returns the types package error that indicates a resource does not exist.
+func (s *Service) notFoundException() string {
+	const resourceNotFoundException = "ResourceNotFoundException"
+
+	if _, ok := s.typesStruct(resourceNotFoundException); ok {
+		return resourceNotFoundException
+	}
+
+	var candidates []string
+	for k, v := range s.types {
+		if _, ok := v.(*ast.StructType); ok && (strings.HasSuffix(k, "NotFoundException") || strings.HasPrefix(k, "NoSuch")) {
+			candidates = append(candidates, k)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return ""
+	}
+
+	slices.Sort(candidates)
+
+	return candidates[0]
+}
+
+// status returns the resource's status, if the resource has an enum status field.
+func (s *Service) status(st *ast.StructType, inTypes bool, resourceName string) *Status {
+	for _, name := range []string{"Status", resourceName + "Status", "State", resourceName + "State"} {
+		f, ok := findField(st, name)
+		if !ok {
+			continue
+		}
+
+		typeName, ok := typesName(f.typ, inTypes)
+		if !ok {
+			continue
+		}
+
+		// The status may be a struct with a status code, e.g. { StatusCode WorkspaceStatusCode }.
+		selector := name
+		if v, ok := s.typesStruct(typeName); ok {
+			var found bool
+			for _, f := range fields(v) {
+				if v, ok := typesName(f.typ, true); ok && s.isEnum(v) {
+					selector, typeName, found = name+"."+f.name, v, true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+
+		if !s.isEnum(typeName) {
+			continue
+		}
+
+		status := &Status{
+			Field: selector,
+			Type:  typeName,
+		}
+		for _, v := range s.enums[typeName] {
+			switch classifyStatus(v.Value) {
+			case statusPending:
+				status.Pending = append(status.Pending, v.Name)
+			case statusTarget:
+				status.Target = append(status.Target, v.Name)
+			case statusDeleting:
+				status.Deleting = append(status.Deleting, v.Name)
+			}
+		}
+
+		return status
+	}
+
+	return nil
+}
+
+type statusClass int
+
+const (
+	statusOther statusClass = iota
+	statusPending
+	statusTarget
+	statusDeleting
+)
+
+var (
+	pendingStatusWords = []string{"creating", "inprogress", "initializing", "modifying", "pending", "provisioning", "starting", "updating"}
+	targetStatusWords  = []string{"active", "available", "complete", "completed", "created", "deployed", "enabled", "healthy", "inservice", "ready", "running", "succeeded", "updated"}
+)
+
+// classifyStatus classifies an enum status value by its wire value, e.g. "CREATE_IN_PROGRESS".
+func classifyStatus(value string) statusClass {
+	v := strings.ToLower(strings.NewReplacer("_", "", "-", "", " ", "").Replace(value))
+
+	switch {
+	case strings.Contains(v, "fail"), strings.Contains(v, "error"), strings.HasSuffix(v, "deleted"), v == "deletecomplete":
+		return statusOther
+	case strings.Contains(v, "delet"):
+		return statusDeleting
+	case slices.ContainsFunc(pendingStatusWords, func(w string) bool { return strings.Contains(v, w) }):
+		return statusPending
+	case slices.Contains(targetStatusWords, v), strings.HasSuffix(v, "complete"):
+		return statusTarget
+	default:
+		return statusOther
+	}
+}
+
+// list returns how the resource's summaries are read from the List operation output.
+func (s *Service) list(out *ast.StructType, op, identifier, resourceName string) (*List, error) {
+	for _, f := range fields(out) {
+		v, ok := f.typ.(*ast.ArrayType)
+		if !ok {
+			continue
+		}
+		typeName, ok := typesName(v.Elt, false)
+		if !ok {
+			continue
+		}
+		st, ok := s.typesStruct(typeName)
+		if !ok {
+			continue
+		}
+
+		for _, id := range []string{identifier, resourceName + "Id", resourceName + "Arn", resourceName + "Name", "Id", "Arn", "Name"} {
+			if field, ok := findField(st, id); ok && isString(field.typ) {
+				return &List{
+					OutputField: f.name,
+					IDField:     id,
+					Paginated:   s.paginators[op],
+				}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no output field lists resource summaries with an identifier")
+}
+
+// builder maps SDK fields to model fields, accumulating nested models.
+type builder struct {
+	service     *Service
+	models      map[string]*Model
+	order       []*Model
+	building    map[string]bool
+	unsupported []string
+}
+
+// field maps a field of the named SDK struct to a model field.
+// inTypes is whether the struct is in the types package; resourceName, if not empty, is a prefix removed from the field name.
+func (b *builder) field(f structField, structName string, inTypes bool, resourceName string, computed bool) (*Field, bool) {
+	field := &Field{
+		SDKName: f.name,
+		Name:    modelFieldName(f.name, resourceName),
+		TFName:  names.ToSnakeCase(trimResourceName(f.name, resourceName)),
+	}
+
+	typ := f.typ
+	if v, ok := typ.(*ast.StarExpr); ok {
+		typ = v.X
+	}
+
+	switch v := typ.(type) {
+	case *ast.ArrayType:
+		if v.Len != nil {
+			break
+		}
+		elt := v.Elt
+		if v, ok := elt.(*ast.StarExpr); ok {
+			elt = v.X
+		}
+
+		if isString(elt) {
+			field.Kind, field.ModelType = "List", "fwtypes.ListOfString"
+			field.CustomType, field.ElementType = "fwtypes.ListOfStringType", "types.StringType"
+			return field, true
+		}
+
+		typeName, ok := typesName(elt, inTypes)
+		if !ok {
+			break
+		}
+		if b.service.isEnum(typeName) {
+			field.Kind, field.ModelType = "List", fmt.Sprintf("fwtypes.ListValueOf[fwtypes.StringEnum[awstypes.%s]]", typeName)
+			field.CustomType, field.ElementType = fmt.Sprintf("fwtypes.ListOfStringEnumType[awstypes.%s]()", typeName), fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", typeName)
+			return field, true
+		}
+		if model, ok := b.model(typeName); ok {
+			b.setNested(field, model, false, computed)
+			return field, true
+		}
+
+	case *ast.MapType:
+		if isString(v.Key) && isString(v.Value) {
+			field.Kind, field.ModelType = "Map", "fwtypes.MapOfString"
+			field.CustomType, field.ElementType = "fwtypes.MapOfStringType", "types.StringType"
+			return field, true
+		}
+
+	case *ast.SelectorExpr:
+		if x, ok := v.X.(*ast.Ident); ok && x.Name == "time" && v.Sel.Name == "Time" {
+			field.Kind, field.ModelType, field.CustomType = "String", "timetypes.RFC3339", "timetypes.RFC3339Type{}"
+			return field, true
+		}
+	}
+
+	switch v := typ.(type) {
+	case *ast.Ident:
+		switch v.Name {
+		case "string":
+			field.Kind, field.ModelType = "String", "types.String"
+			if strings.HasSuffix(field.Name, "ARN") && !computed {
+				field.ModelType, field.CustomType = "fwtypes.ARN", "fwtypes.ARNType"
+			}
+			return field, true
+		case "bool":
+			field.Kind, field.ModelType = "Bool", "types.Bool"
+			return field, true
+		case "int32":
+			field.Kind, field.ModelType = "Int32", "types.Int32"
+			return field, true
+		case "int", "int64":
+			field.Kind, field.ModelType = "Int64", "types.Int64"
+			return field, true
+		case "float32":
+			field.Kind, field.ModelType = "Float32", "types.Float32"
+			return field, true
+		case "float64":
+			field.Kind, field.ModelType = "Float64", "types.Float64"
+			return field, true
+		}
+	}
+
+	if typeName, ok := typesName(typ, inTypes); ok {
+		if b.service.isEnum(typeName) {
+			field.Kind, field.ModelType, field.CustomType = "String", fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", typeName), fmt.Sprintf("fwtypes.StringEnumType[awstypes.%s]()", typeName)
+			return field, true
+		}
+		if model, ok := b.model(typeName); ok {
+			b.setNested(field, model, true, computed)
+			return field, true
+		}
+	}
+
+	b.unsupported = append(b.unsupported, fmt.Sprintf("%s.%s (%s)", structName, f.name, exprString(f.typ)))
+
+	return nil, false
+}
+
+func (b *builder) setNested(field *Field, model *Model, maxItems1, computed bool) {
+	field.Kind = "List"
+	field.ModelType = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", model.Name)
+	field.CustomType = fmt.Sprintf("fwtypes.NewListNestedObjectTypeOf[%s](ctx)", model.Name)
+	field.Nested = model
+	field.MaxItems1 = maxItems1
+
+	if computed {
+		field.Builtin = fmt.Sprintf("framework.ResourceComputedListOfObjectAttribute[%s](ctx)", model.Name)
+	}
+}
+
+// model returns the model for the named types package struct, building it if necessary.
+func (b *builder) model(typeName string) (*Model, bool) {
+	if v, ok := b.models[typeName]; ok {
+		return v, true
+	}
+
+	st, ok := b.service.typesStruct(typeName)
+	if !ok || b.building[typeName] {
+		// Not a struct, or a recursive struct.
+		return nil, false
+	}
+
+	b.building[typeName] = true
+	defer delete(b.building, typeName)
+
+	model := &Model{Name: lowerFirst(typeName) + "Model"}
+
+	for _, f := range fields(st) {
+		field, ok := b.field(f, typeName, true, "", false)
+		if !ok {
+			continue
+		}
+
+		field.Required = f.required
+		field.Optional = !f.required
+
+		model.Fields = append(model.Fields, field)
+	}
+
+	b.models[typeName] = model
+	b.order = append(b.order, model)
+
+	return model, true
+}
+
+// typesName returns the name of the types package type referenced by expr.
+// In the types package itself types are referenced by identifier, elsewhere by "types." selector.
+func typesName(expr ast.Expr, inTypes bool) (string, bool) {
+	if v, ok := expr.(*ast.StarExpr); ok {
+		expr = v.X
+	}
+
+	switch v := expr.(type) {
+	case *ast.Ident:
+		if inTypes && v.IsExported() {
+			return v.Name, true
+		}
+	case *ast.SelectorExpr:
+		if x, ok := v.X.(*ast.Ident); ok && x.Name == "types" && !inTypes {
+			return v.Sel.Name, true
+		}
+	}
+
+	return "", false
+}
+
+func isString(expr ast.Expr) bool {
+	if v, ok := expr.(*ast.StarExpr); ok {
+		expr = v.X
+	}
+	v, ok := expr.(*ast.Ident)
+
+	return ok && v.Name == "string"
+}
+
+func exprString(expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.Ident:
+		return v.Name
+	case *ast.StarExpr:
+		return "*" + exprString(v.X)
+	case *ast.SelectorExpr:
+		return exprString(v.X) + "." + v.Sel.Name
+	case *ast.ArrayType:
+		return "[]" + exprString(v.Elt)
+	case *ast.MapType:
+		return "map[" + exprString(v.Key) + "]" + exprString(v.Value)
+	case *ast.InterfaceType:
+		return "interface{}"
+	default:
+		return fmt.Sprintf("%T", expr)
+	}
+}
+
+// trimResourceName removes the resource name prefix from a field name, e.g. "WorkspaceId" -> "Id".
+func trimResourceName(fieldName, resourceName string) string {
+	if resourceName == "" {
+		return fieldName
+	}
+
+	if v, ok := strings.CutPrefix(fieldName, resourceName); ok && v != "" && v[0] >= 'A' && v[0] <= 'Z' {
+		return v
+	}
+
+	return fieldName
+}
+
+// modelFieldName returns the model struct field name for an SDK field name.
+// AutoFlex matches the names case-insensitively and with the resource name as field name prefix.
+func modelFieldName(fieldName, resourceName string) string {
+	var sb strings.Builder
+
+	for _, word := range camelWords(trimResourceName(fieldName, resourceName)) {
+		if v, ok := initialisms[strings.ToLower(word)]; ok {
+			word = v
+		}
+		sb.WriteString(word)
+	}
+
+	return sb.String()
+}
+
+// camelWords splits a camel-cased name into words, e.g. "KmsKeyARN" -> ["Kms", "Key", "ARN"].
+func camelWords(s string) []string {
+	var words []string
+
+	start := 0
+	for i := 1; i < len(s); i++ {
+		prev, curr := s[i-1], s[i]
+		next := byte(0)
+		if i+1 < len(s) {
+			next = s[i+1]
+		}
+
+		if isUpper(curr) && (!isUpper(prev) || (next != 0 && isLower(next))) {
+			words = append(words, s[start:i])
+			start = i
+		}
+	}
+
+	return append(words, s[start:])
+}
+
+func isUpper(ch byte) bool {
+	return ch >= 'A' && ch <= 'Z'
+}
+
+func isLower(ch byte) bool {
+	return ch >= 'a' && ch <= 'z'
+}
+
+func lowerFirst(s string) string {
+	words := camelWords(s)
+	if len(words) == 0 {
+		return s
+	}
+
+	return strings.ToLower(words[0]) + strings.Join(words[1:], "")
+}
+
+// initialisms are the capitalizations of common initialisms in Go names.
+var initialisms = map[string]string{
+	"acl":   "ACL",
+	"ami":   "AMI",
+	"api":   "API",
+	"arn":   "ARN",
+	"arns":  "ARNs",
+	"az":    "AZ",
+	"cidr":  "CIDR",
+	"cpu":   "CPU",
+	"db":    "DB",
+	"dns":   "DNS",
+	"ebs":   "EBS",
+	"ec2":   "EC2",
+	"http":  "HTTP",
+	"https": "HTTPS",
+	"iam":   "IAM",
+	"id":    "ID",
+	"ids":   "IDs",
+	"ip":    "IP",
+	"json":  "JSON",
+	"kms":   "KMS",
+	"mfa":   "MFA",
+	"s3":    "S3",
+	"sns":   "SNS",
+	"sqs":   "SQS",
+	"ssh":   "SSH",
+	"ssl":   "SSL",
+	"tls":   "TLS",
+	"ttl":   "TTL",
+	"uri":   "URI",
+	"url":   "URL",
+	"uuid":  "UUID",
+	"vpc":   "VPC",
+	"xml":   "XML",
+}
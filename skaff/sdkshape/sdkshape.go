@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package sdkshape inspects the API shapes (operation input and output structs and
+// the structs and enums of the types package) of an AWS SDK for Go v2 service package.
+//
+// The SDK source is parsed rather than compiled, so any service module in the Go module
+// cache can be inspected without the service package being imported.
+package sdkshape
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	sdkServiceModulePathPrefix = "github.com/aws/aws-sdk-go-v2/service/"
+
+	requiredMemberDoc = "This member is required."
+)
+
+// ModuleDir returns the directory containing the source of the specified AWS SDK for Go v2
+// service module (e.g. "amp"), as resolved by the Go module in the current working directory.
+func ModuleDir(sdkPackage string) (string, error) {
+	cmd := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", sdkServiceModulePathPrefix+sdkPackage)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("locating AWS SDK for Go v2 module for %q: %w: %s", sdkPackage, err, strings.TrimSpace(stderr.String()))
+	}
+
+	dir := strings.TrimSpace(string(out))
+	if dir == "" {
+		return "", fmt.Errorf("locating AWS SDK for Go v2 module for %q: module source not downloaded", sdkPackage)
+	}
+
+	return dir, nil
+}
+
+// Service holds the API shapes of an AWS SDK for Go v2 service package.
+type Service struct {
+	// Package is the name of the service package, e.g. "amp".
+	Package string
+
+	// structs are the service package's structs, keyed by name (e.g. "CreateWorkspaceInput").
+	structs map[string]*ast.StructType
+	// paginators are the operations for which the service package has a paginator.
+	paginators map[string]bool
+	// types are the types package's type definitions, keyed by name.
+	types map[string]ast.Expr
+	// enums are the types package's enum values, keyed by enum type name.
+	enums map[string][]EnumValue
+}
+
+// EnumValue is one value of an SDK enum type.
+type EnumValue struct {
+	// Name is the Go constant name, e.g. "WorkspaceStatusCodeActive".
+	Name string
+	// Value is the wire value, e.g. "ACTIVE".
+	Value string
+}
+
+// Load parses the service package in dir and its types package.
+func Load(sdkPackage, dir string) (*Service, error) {
+	s := &Service{
+		Package:    sdkPackage,
+		structs:    make(map[string]*ast.StructType),
+		paginators: make(map[string]bool),
+		types:      make(map[string]ast.Expr),
+		enums:      make(map[string][]EnumValue),
+	}
+
+	err := parseDir(dir, func(f *ast.File) {
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						if v, ok := spec.Type.(*ast.StructType); ok {
+							s.structs[spec.Name.Name] = v
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if op, ok := strings.CutPrefix(decl.Name.Name, "New"); ok && decl.Recv == nil {
+					if op, ok := strings.CutSuffix(op, "Paginator"); ok {
+						s.paginators[op] = true
+					}
+				}
+			}
+		}
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = parseDir(filepath.Join(dir, "types"), func(f *ast.File) {
+		for _, decl := range f.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			switch decl.Tok {
+			case token.TYPE:
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						s.types[spec.Name.Name] = spec.Type
+					}
+				}
+			case token.CONST:
+				for _, spec := range decl.Specs {
+					spec, ok := spec.(*ast.ValueSpec)
+					if !ok || len(spec.Names) != 1 || len(spec.Values) != 1 {
+						continue
+					}
+					typ, ok := spec.Type.(*ast.Ident)
+					if !ok {
+						continue
+					}
+					lit, ok := spec.Values[0].(*ast.BasicLit)
+					if !ok || lit.Kind != token.STRING {
+						continue
+					}
+					value, err := strconv.Unquote(lit.Value)
+					if err != nil {
+						continue
+					}
+
+					s.enums[typ.Name] = append(s.enums[typ.Name], EnumValue{
+						Name:  spec.Names[0].Name,
+						Value: value,
+					})
+				}
+			}
+		}
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// parseDir parses the non-test Go source files in dir, calling fn for each.
+func parseDir(dir string, fn func(*ast.File)) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("reading directory (%s): %w", dir, err)
+	}
+
+	fset := token.NewFileSet()
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return fmt.Errorf("parsing (%s): %w", name, err)
+		}
+
+		fn(f)
+	}
+
+	return nil
+}
+
+// inputStruct returns the input struct of the specified operation.
+func (s *Service) inputStruct(op string) (*ast.StructType, error) {
+	return s.operationStruct(op, "Input")
+}
+
+// outputStruct returns the output struct of the specified operation.
+func (s *Service) outputStruct(op string) (*ast.StructType, error) {
+	return s.operationStruct(op, "Output")
+}
+
+func (s *Service) operationStruct(op, suffix string) (*ast.StructType, error) {
+	v, ok := s.structs[op+suffix]
+	if !ok {
+		return nil, fmt.Errorf("operation %q not found in AWS SDK for Go v2 service package %q", op, s.Package)
+	}
+
+	return v, nil
+}
+
+// typesStruct returns the named struct from the types package.
+func (s *Service) typesStruct(name string) (*ast.StructType, bool) {
+	v, ok := s.types[name].(*ast.StructType)
+
+	return v, ok
+}
+
+// isEnum returns whether the named type from the types package is a string enum.
+func (s *Service) isEnum(name string) bool {
+	v, ok := s.types[name].(*ast.Ident)
+
+	return ok && v.Name == "string"
+}
+
+// structField is an exported field of an SDK struct.
+type structField struct {
+	name     string
+	typ      ast.Expr
+	required bool
+}
+
+// fields returns the exported, named fields of an SDK struct in declaration order.
+func fields(st *ast.StructType) []structField {
+	var fields []structField
+
+	for _, field := range st.Fields.List {
+		required := field.Doc != nil && strings.Contains(field.Doc.Text(), requiredMemberDoc)
+
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+
+			fields = append(fields, structField{
+				name:     name.Name,
+				typ:      field.Type,
+				required: required,
+			})
+		}
+	}
+
+	return fields
+}
+
+// findField returns the named field of an SDK struct.
+func findField(st *ast.StructType, name string) (structField, bool) {
+	for _, field := range fields(st) {
+		if field.name == name {
+			return field, true
+		}
+	}
+
+	return structField{}, false
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkshape
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestResource(t *testing.T) {
+	s, err := Load("widgets", "testdata/widgets")
+	if err != nil {
+		t.Fatalf("loading: %s", err)
+	}
+
+	r, err := s.Resource("Widget", Operations{
+		Create: "CreateWidget",
+		Read:   "GetWidget",
+		Update: "UpdateWidget",
+		Delete: "DeleteWidget",
+		List:   "ListWidgets",
+	})
+	if err != nil {
+		t.Fatalf("deriving resource: %s", err)
+	}
+
+	if got, want := r.Identifier, "WidgetId"; got != want {
+		t.Errorf("Identifier = %q, want %q", got, want)
+	}
+	if got, want := r.CreateOutputIdentifier, "WidgetId"; got != want {
+		t.Errorf("CreateOutputIdentifier = %q, want %q", got, want)
+	}
+	if got, want := r.DeleteIdentifier, "WidgetId"; got != want {
+		t.Errorf("DeleteIdentifier = %q, want %q", got, want)
+	}
+	if got, want := r.ReadOutputField, "Widget"; got != want {
+		t.Errorf("ReadOutputField = %q, want %q", got, want)
+	}
+	if got, want := r.ReadType, "awstypes.Widget"; got != want {
+		t.Errorf("ReadType = %q, want %q", got, want)
+	}
+	if got, want := r.NotFoundException, "ResourceNotFoundException"; got != want {
+		t.Errorf("NotFoundException = %q, want %q", got, want)
+	}
+	if !r.Tags {
+		t.Error("Tags = false, want true")
+	}
+
+	type field struct {
+		name, tfName, modelType                string
+		required, optional, computed, forceNew bool
+	}
+	var got []field
+	for _, f := range r.Model.Fields {
+		got = append(got, field{f.Name, f.TFName, f.ModelType, f.Required, f.Optional, f.Computed, f.ForceNew})
+	}
+	want := []field{
+		{"ARN", "arn", "types.String", false, false, true, false},
+		{"Configuration", "configuration", "fwtypes.ListNestedObjectValueOf[widgetConfigurationModel]", false, true, false, true},
+		{"CreatedAt", "created_at", "timetypes.RFC3339", false, false, true, false},
+		{"Description", "description", "types.String", false, true, false, false},
+		{"ID", "id", "types.String", false, false, true, false},
+		{"KMSKeyARN", "kms_key_arn", "fwtypes.ARN", false, true, false, true},
+		{"Name", "name", "types.String", true, false, false, true},
+		{"Status", "status", "fwtypes.StringEnum[awstypes.WidgetStatus]", false, false, true, false},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Model.Fields = %v, want %v", got, want)
+	}
+
+	var models []string
+	for _, m := range r.NestedModels {
+		models = append(models, m.Name)
+	}
+	if want := []string{"widgetPartModel", "widgetConfigurationModel"}; !slices.Equal(models, want) {
+		t.Errorf("NestedModels = %v, want %v", models, want)
+	}
+
+	if want := []string{"WidgetPart.Parent (*WidgetPart)", "WidgetConfiguration.Metadata (document.Interface)"}; !slices.Equal(r.Unsupported, want) {
+		t.Errorf("Unsupported = %v, want %v", r.Unsupported, want)
+	}
+
+	if r.Status == nil {
+		t.Fatal("Status = nil")
+	}
+	if got, want := r.Status.Field, "Status"; got != want {
+		t.Errorf("Status.Field = %q, want %q", got, want)
+	}
+	if got, want := r.Status.Pending, []string{"WidgetStatusCreating", "WidgetStatusUpdating"}; !slices.Equal(got, want) {
+		t.Errorf("Status.Pending = %v, want %v", got, want)
+	}
+	if got, want := r.Status.Target, []string{"WidgetStatusActive"}; !slices.Equal(got, want) {
+		t.Errorf("Status.Target = %v, want %v", got, want)
+	}
+	if got, want := r.Status.Deleting, []string{"WidgetStatusDeleting"}; !slices.Equal(got, want) {
+		t.Errorf("Status.Deleting = %v, want %v", got, want)
+	}
+
+	if r.List == nil {
+		t.Fatal("List = nil")
+	}
+	if got, want := *r.List, (List{OutputField: "Widgets", IDField: "WidgetId", Paginated: true}); got != want {
+		t.Errorf("List = %v, want %v", got, want)
+	}
+}
+
+func TestResourceMissingOperation(t *testing.T) {
+	s, err := Load("widgets", "testdata/widgets")
+	if err != nil {
+		t.Fatalf("loading: %s", err)
+	}
+
+	if _, err := s.Resource("Widget", Operations{Create: "CreateWidget", Read: "DescribeWidget", Delete: "DeleteWidget"}); err == nil {
+		t.Error("expected error for unknown operation")
+	}
+}
+
+func TestModelFieldName(t *testing.T) {
+	testCases := []struct {
+		TestName     string
+		FieldName    string
+		ResourceName string
+		Expected     string
+	}{
+		{
+			TestName:  "simple",
+			FieldName: "Description",
+			Expected:  "Description",
+		},
+		{
+			TestName:  "initialisms",
+			FieldName: "KmsKeyArn",
+			Expected:  "KMSKeyARN",
+		},
+		{
+			TestName:  "already capitalized",
+			FieldName: "DBSubnetGroupName",
+			Expected:  "DBSubnetGroupName",
+		},
+		{
+			TestName:     "resource name prefix",
+			FieldName:    "WidgetId",
+			ResourceName: "Widget",
+			Expected:     "ID",
+		},
+		{
+			TestName:     "resource name not a word prefix",
+			FieldName:    "Widgets",
+			ResourceName: "Widget",
+			Expected:     "Widgets",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := modelFieldName(testCase.FieldName, testCase.ResourceName)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestClassifyStatus(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Value    string
+		Expected statusClass
+	}{
+		{TestName: "creating", Value: "CREATING", Expected: statusPending},
+		{TestName: "in progress", Value: "CREATE_IN_PROGRESS", Expected: statusPending},
+		{TestName: "active", Value: "ACTIVE", Expected: statusTarget},
+		{TestName: "complete", Value: "CREATE_COMPLETE", Expected: statusTarget},
+		{TestName: "deleting", Value: "DELETING", Expected: statusDeleting},
+		{TestName: "deleted", Value: "DELETED", Expected: statusOther},
+		{TestName: "delete complete", Value: "DELETE_COMPLETE", Expected: statusOther},
+		{TestName: "failed", Value: "CREATE_FAILED", Expected: statusOther},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := classifyStatus(testCase.Value)
+
+			if got != testCase.Expected {
+				t.Errorf("got %d, expected %d", got, testCase.Expected)
+			}
+		})
+	}
+}